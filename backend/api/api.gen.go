@@ -6,24 +6,137 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	CookieAuthScopes = "cookieAuth.Scopes"
+)
+
+// Defines values for DuplicateCandidateReasons.
+const (
+	DuplicateCandidateReasonsEmail      DuplicateCandidateReasons = "email"
+	DuplicateCandidateReasonsLineUserId DuplicateCandidateReasons = "line_user_id"
+	DuplicateCandidateReasonsName       DuplicateCandidateReasons = "name"
+	DuplicateCandidateReasonsStudentId  DuplicateCandidateReasons = "student_id"
+)
+
+// Defines values for ImportRowResultStatus.
+const (
+	Created ImportRowResultStatus = "created"
+	Invalid ImportRowResultStatus = "invalid"
+	Valid   ImportRowResultStatus = "valid"
+)
+
+// Defines values for InvitationStatus.
+const (
+	Expired     InvitationStatus = "expired"
+	Outstanding InvitationStatus = "outstanding"
+	Redeemed    InvitationStatus = "redeemed"
+)
+
 // Defines values for MemberDetailEventsStatus.
 const (
 	Completed MemberDetailEventsStatus = "completed"
 	Upcoming  MemberDetailEventsStatus = "upcoming"
 )
 
+// Defines values for MemberSearchHitMatchedFields.
+const (
+	MemberSearchHitMatchedFieldsBio      MemberSearchHitMatchedFields = "bio"
+	MemberSearchHitMatchedFieldsName     MemberSearchHitMatchedFields = "name"
+	MemberSearchHitMatchedFieldsNickname MemberSearchHitMatchedFields = "nickname"
+	MemberSearchHitMatchedFieldsRoles    MemberSearchHitMatchedFields = "roles"
+)
+
+// Defines values for MemberStatus.
+const (
+	Active    MemberStatus = "active"
+	Alumni    MemberStatus = "alumni"
+	Pending   MemberStatus = "pending"
+	Suspended MemberStatus = "suspended"
+)
+
+// Defines values for TeamKind.
+const (
+	TeamKindOfficer TeamKind = "officer"
+	TeamKindTeam    TeamKind = "team"
+)
+
+// Defines values for GetApiMembersExportParamsFormat.
+const (
+	Csv  GetApiMembersExportParamsFormat = "csv"
+	Json GetApiMembersExportParamsFormat = "json"
+	Xlsx GetApiMembersExportParamsFormat = "xlsx"
+)
+
+// Defines values for GetApiMembersExportParamsColumns.
+const (
+	CreatedAt      GetApiMembersExportParamsColumns = "created_at"
+	Department     GetApiMembersExportParamsColumns = "department"
+	Email          GetApiMembersExportParamsColumns = "email"
+	EnrollmentYear GetApiMembersExportParamsColumns = "enrollment_year"
+	Id             GetApiMembersExportParamsColumns = "id"
+	Name           GetApiMembersExportParamsColumns = "name"
+	Nickname       GetApiMembersExportParamsColumns = "nickname"
+	Roles          GetApiMembersExportParamsColumns = "roles"
+	Status         GetApiMembersExportParamsColumns = "status"
+	StudentId      GetApiMembersExportParamsColumns = "student_id"
+	Tags           GetApiMembersExportParamsColumns = "tags"
+	Year           GetApiMembersExportParamsColumns = "year"
+)
+
+// AccountLink defines model for AccountLink.
+type AccountLink struct {
+	// AccountId 連携先でのユーザーID
+	AccountId string `json:"account_id"`
+
+	// ProfileUrl 連携先のプロフィールURL（GitHubのみ）
+	ProfileUrl *string `json:"profile_url,omitempty"`
+
+	// Provider 連携先（discord / github）
+	Provider string `json:"provider"`
+
+	// Username 連携先でのユーザー名
+	Username string `json:"username"`
+}
+
+// AuthResult defines model for AuthResult.
+type AuthResult struct {
+	// DisplayName プロバイダでの表示名
+	DisplayName string `json:"display_name"`
+
+	// Email プロバイダが返したメールアドレス
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// MemberId ログインしたメンバーのID（未登録なら省略）
+	MemberId *string `json:"member_id,omitempty"`
+
+	// PictureUrl プロバイダでのプロフィール画像URL
+	PictureUrl *string `json:"picture_url,omitempty"`
+
+	// Provider ログインに使ったプロバイダ
+	Provider string `json:"provider"`
+
+	// Subject プロバイダでのユーザーID
+	Subject string `json:"subject"`
+}
+
 // BasicInfo defines model for BasicInfo.
 type BasicInfo struct {
-	Faculty   string `json:"faculty"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Nickname  string `json:"nickname"`
+	// EnrollmentYear 入学年度。学年は年度（4月始まり）から自動で計算します
+	EnrollmentYear *int   `json:"enrollment_year,omitempty"`
+	Faculty        string `json:"faculty"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	Nickname       string `json:"nickname"`
+
+	// ProgramYears 修業年限（学部4、修士2など）
+	ProgramYears *int `json:"program_years,omitempty"`
 
 	// SelfIntroduction Markdown形式の自己紹介文
 	SelfIntroduction string     `json:"self_introduction"`
@@ -31,34 +144,98 @@ type BasicInfo struct {
 	Visibility       Visibility `json:"visibility"`
 }
 
-// LineOAuthResponse defines model for LineOAuthResponse.
-type LineOAuthResponse struct {
-	// AccessToken LINEから発行されたアクセストークン
-	AccessToken string `json:"access_token"`
+// DuplicateCandidate defines model for DuplicateCandidate.
+type DuplicateCandidate struct {
+	Members []MemberSummary `json:"members"`
 
-	// ExpiresIn アクセストークンの有効期限(秒)
-	ExpiresIn int `json:"expires_in"`
+	// Reasons 一致・類似した項目
+	Reasons []DuplicateCandidateReasons `json:"reasons"`
 
-	// RefreshToken 必要に応じて返されるリフレッシュトークン
-	RefreshToken *string  `json:"refresh_token,omitempty"`
-	User         LineUser `json:"user"`
+	// Score 同じ人らしさ（0〜1）
+	Score float32 `json:"score"`
 }
 
-// LineUser defines model for LineUser.
-type LineUser struct {
-	// DisplayName 表示名
-	DisplayName string `json:"display_name"`
+// DuplicateCandidateReasons defines model for DuplicateCandidate.Reasons.
+type DuplicateCandidateReasons string
 
-	// PictureUrl プロフィール画像URL
-	PictureUrl *string `json:"picture_url,omitempty"`
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Created 登録した人数（dry_run のときは 0）
+	Created int `json:"created"`
+
+	// DryRun true なら何も登録していない
+	DryRun bool              `json:"dry_run"`
+	Rows   []ImportRowResult `json:"rows"`
+
+	// Total 見出し行を除いた行数
+	Total int `json:"total"`
+
+	// Valid エラーのない行数
+	Valid int `json:"valid"`
+}
 
-	// StatusMessage ステータスメッセージ
-	StatusMessage *string `json:"status_message,omitempty"`
+// ImportRowResult defines model for ImportRowResult.
+type ImportRowResult struct {
+	Errors []string `json:"errors"`
 
-	// UserId LINEユーザーID
-	UserId string `json:"user_id"`
+	// Id 登録したメンバーのID（created のときのみ）
+	Id *string `json:"id,omitempty"`
+
+	// Line CSVの行番号（見出し行が1）
+	Line int     `json:"line"`
+	Name *string `json:"name,omitempty"`
+
+	// Status valid は登録できる行（dry_run のとき）、created は登録した行、invalid はエラーのある行
+	Status    ImportRowResultStatus `json:"status"`
+	StudentId *string               `json:"student_id,omitempty"`
+}
+
+// ImportRowResultStatus valid は登録できる行（dry_run のとき）、created は登録した行、invalid はエラーのある行
+type ImportRowResultStatus string
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	Code      string    `json:"code"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy 発行した役員のメンバーID
+	CreatedBy   string    `json:"created_by"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	MaxUses     int       `json:"max_uses"`
+	Note        *string   `json:"note,omitempty"`
+	Redemptions []struct {
+		MemberId   string    `json:"member_id"`
+		RedeemedAt time.Time `json:"redeemed_at"`
+	} `json:"redemptions"`
+	Roles []string `json:"roles"`
+
+	// Status outstanding=使用可能、redeemed=使用回数の上限に達した、expired=期限切れ
+	Status InvitationStatus `json:"status"`
+	Uses   int              `json:"uses"`
+	Year   *string          `json:"year,omitempty"`
+}
+
+// InvitationCreate defines model for InvitationCreate.
+type InvitationCreate struct {
+	// ExpiresInHours 有効期間（時間）
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+
+	// MaxUses 使用できる回数
+	MaxUses *int `json:"max_uses,omitempty"`
+
+	// Note 役員向けのメモ（誰に渡したかなど）
+	Note *string `json:"note,omitempty"`
+
+	// Roles 登録時に付与する班・役職の名前かID。登録済みの班・役職でなければエラーになります
+	Roles *[]string `json:"roles,omitempty"`
+
+	// Year 登録時に設定する学年
+	Year *string `json:"year,omitempty"`
 }
 
+// InvitationStatus outstanding=使用可能、redeemed=使用回数の上限に達した、expired=期限切れ
+type InvitationStatus string
+
 // MemberCreate defines model for MemberCreate.
 type MemberCreate struct {
 	Accounts struct {
@@ -71,14 +248,31 @@ type MemberCreate struct {
 	// Bio Markdown形式の自己紹介
 	Bio        string `json:"bio"`
 	Department string `json:"department"`
-	Links      *[]struct {
+
+	// EnrollmentYear 入学年度。指定すると year の代わりに学年を自動で計算します
+	EnrollmentYear *int `json:"enrollment_year,omitempty"`
+
+	// InvitationCode 役員から受け取った招待コード（役員が登録する場合は不要）
+	InvitationCode *string `json:"invitation_code,omitempty"`
+	Links          *[]struct {
 		Title string `json:"title"`
 		Url   string `json:"url"`
 	} `json:"links,omitempty"`
-	Name     string   `json:"name"`
-	Nickname string   `json:"nickname"`
-	Roles    []string `json:"roles"`
-	Year     string   `json:"year"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+
+	// ProgramYears 修業年限（学部4、修士2など）
+	ProgramYears *int `json:"program_years,omitempty"`
+
+	// Roles 班・役職の名前かID。登録済みの班・役職でなければエラーになります
+	Roles []string `json:"roles"`
+
+	// StudentId 学籍番号（全角・小文字は半角・大文字にそろえます）。登録済みのメンバーと同じ番号は登録できません。API の応答には含めません
+	StudentId *string `json:"student_id,omitempty"`
+
+	// Tags スキル・興味タグ
+	Tags *[]string `json:"tags,omitempty"`
+	Year string    `json:"year"`
 }
 
 // MemberCreateResponse defines model for MemberCreateResponse.
@@ -98,36 +292,205 @@ type MemberDetail struct {
 	Avatar *string `json:"avatar,omitempty"`
 
 	// Bio Markdown形式の自己紹介
-	Bio        string `json:"bio"`
-	Department string `json:"department"`
-	Events     []struct {
+	Bio string `json:"bio"`
+
+	// DeletedAt 削除された日時（削除済みのメンバーのみ）
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+	Department string     `json:"department"`
+
+	// EnrollmentYear 入学年度
+	EnrollmentYear *int `json:"enrollment_year,omitempty"`
+	Events         []struct {
 		Date   openapi_types.Date       `json:"date"`
 		Name   string                   `json:"name"`
 		Status MemberDetailEventsStatus `json:"status"`
 	} `json:"events"`
+
+	// Grade 現在の学年（入学年度がない場合と卒業生は省略）
+	Grade *int   `json:"grade,omitempty"`
 	Id    string `json:"id"`
 	Links []struct {
 		Title string `json:"title"`
 		Url   string `json:"url"`
 	} `json:"links"`
-	Name     string   `json:"name"`
-	Nickname string   `json:"nickname"`
-	Roles    []string `json:"roles"`
-	Year     string   `json:"year"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+
+	// ProgramYears 修業年限（学部4、修士2など）
+	ProgramYears *int `json:"program_years,omitempty"`
+
+	// RoleHistory これまでと現在の役職の任期（新しい順）
+	RoleHistory *[]RoleTerm `json:"role_history,omitempty"`
+
+	// RoleIds roles に対応する班・役職のID
+	RoleIds *[]string `json:"role_ids,omitempty"`
+	Roles   []string  `json:"roles"`
+
+	// Social 表示してよいSNSアカウント（本人以外には visibility が true のものだけ）
+	Social *MemberSocial `json:"social,omitempty"`
+
+	// Status pending=承認待ち、active=在籍中、alumni=卒業生、suspended=利用停止
+	Status *MemberStatus `json:"status,omitempty"`
+
+	// Tags スキル・興味タグ
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Year 表示用の学年。入学年度があれば年度（4月始まり）から自動で計算します（卒業生は「2025年度卒」）
+	Year string `json:"year"`
 }
 
 // MemberDetailEventsStatus defines model for MemberDetail.Events.Status.
 type MemberDetailEventsStatus string
 
+// MemberMerge defines model for MemberMerge.
+type MemberMerge struct {
+	// SourceId 統合して消すメンバーのID
+	SourceId string `json:"source_id"`
+}
+
+// MemberSearchHit defines model for MemberSearchHit.
+type MemberSearchHit struct {
+	// MatchedFields 一致したフィールド
+	MatchedFields []MemberSearchHitMatchedFields `json:"matched_fields"`
+	Member        MemberSummary                  `json:"member"`
+
+	// Score 一致度（大きいほどよく一致）
+	Score float32 `json:"score"`
+}
+
+// MemberSearchHitMatchedFields defines model for MemberSearchHit.MatchedFields.
+type MemberSearchHitMatchedFields string
+
+// MemberSocial 表示してよいSNSアカウント（本人以外には visibility が true のものだけ）
+type MemberSocial struct {
+	Github    *SocialAccount `json:"github,omitempty"`
+	Instagram *SocialAccount `json:"instagram,omitempty"`
+	Website   *SocialAccount `json:"website,omitempty"`
+	X         *SocialAccount `json:"x,omitempty"`
+}
+
+// MemberStatus pending=承認待ち、active=在籍中、alumni=卒業生、suspended=利用停止
+type MemberStatus string
+
+// MemberStatusUpdate defines model for MemberStatusUpdate.
+type MemberStatusUpdate struct {
+	// Status pending=承認待ち、active=在籍中、alumni=卒業生、suspended=利用停止
+	Status MemberStatus `json:"status"`
+}
+
 // MemberSummary defines model for MemberSummary.
 type MemberSummary struct {
-	Avatar   *string  `json:"avatar,omitempty"`
-	Id       string   `json:"id"`
-	Name     string   `json:"name"`
-	Nickname string   `json:"nickname"`
-	Roles    []string `json:"roles"`
+	Avatar   *string `json:"avatar,omitempty"`
+	Id       string  `json:"id"`
+	Name     string  `json:"name"`
+	Nickname string  `json:"nickname"`
+
+	// RoleIds roles に対応する班・役職のID
+	RoleIds *[]string `json:"role_ids,omitempty"`
+	Roles   []string  `json:"roles"`
+
+	// Status pending=承認待ち、active=在籍中、alumni=卒業生、suspended=利用停止
+	Status *MemberStatus `json:"status,omitempty"`
+
+	// Tags スキル・興味タグ
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// RoleTerm defines model for RoleTerm.
+type RoleTerm struct {
+	// AcademicYear 任期が始まった年度
+	AcademicYear int `json:"academic_year"`
+
+	// Current 現在任期中か
+	Current  bool                `json:"current"`
+	EndDate  *openapi_types.Date `json:"end_date,omitempty"`
+	Id       string              `json:"id"`
+	MemberId string              `json:"member_id"`
+
+	// MemberName 記録したときのメンバーの名前
+	MemberName string             `json:"member_name"`
+	Role       string             `json:"role"`
+	RoleId     string             `json:"role_id"`
+	StartDate  openapi_types.Date `json:"start_date"`
+}
+
+// RoleTermCreate defines model for RoleTermCreate.
+type RoleTermCreate struct {
+	// AcademicYear 任期の年度（start_date と同時には指定できない）
+	AcademicYear *int `json:"academic_year,omitempty"`
+
+	// EndDate 任期の最終日。省略すると任期中として扱う
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Role 班・役職の名前かID
+	Role      string              `json:"role"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// SimilarMember defines model for SimilarMember.
+type SimilarMember struct {
+	Member MemberSummary `json:"member"`
+
+	// Score 興味の近さ（0〜1、大きいほど近い）
+	Score      float32  `json:"score"`
+	SharedTags []string `json:"shared_tags"`
+}
+
+// SocialAccount defines model for SocialAccount.
+type SocialAccount struct {
+	Handle string `json:"handle"`
+	Url    string `json:"url"`
+}
+
+// SocialHandles SNSのユーザー名と個人サイト。保存時に形式を確認し、ユーザー名・URLにそろえます
+type SocialHandles struct {
+	Github    *string `json:"github,omitempty"`
+	Instagram *string `json:"instagram,omitempty"`
+	Website   *string `json:"website,omitempty"`
+	X         *string `json:"x,omitempty"`
+}
+
+// SocialProfile defines model for SocialProfile.
+type SocialProfile struct {
+	// Handles SNSのユーザー名と個人サイト。保存時に形式を確認し、ユーザー名・URLにそろえます
+	Handles    SocialHandles `json:"handles"`
+	Visibility Visibility    `json:"visibility"`
+}
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Count int    `json:"count"`
+	Tag   string `json:"tag"`
+}
+
+// TagsUpdate defines model for TagsUpdate.
+type TagsUpdate struct {
+	Tags []string `json:"tags"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Id string `json:"id"`
+
+	// Kind team=班、officer=役職（代表・会計など）
+	Kind  TeamKind `json:"kind"`
+	Name  string   `json:"name"`
+	Order int      `json:"order"`
+}
+
+// TeamInput defines model for TeamInput.
+type TeamInput struct {
+	// Kind team=班、officer=役職（代表・会計など）
+	Kind TeamKind `json:"kind"`
+	Name string   `json:"name"`
+
+	// Order 表示順（小さいほど先）
+	Order *int `json:"order,omitempty"`
 }
 
+// TeamKind team=班、officer=役職（代表・会計など）
+type TeamKind string
+
 // UpdateResponse defines model for UpdateResponse.
 type UpdateResponse struct {
 	Message *string `json:"message,omitempty"`
@@ -136,10 +499,35 @@ type UpdateResponse struct {
 
 // Visibility defines model for Visibility.
 type Visibility struct {
-	Instagram        bool `json:"instagram"`
-	Name             bool `json:"name"`
-	SelfIntroduction bool `json:"self_introduction"`
-	X                bool `json:"x"`
+	// Github 省略時は非公開
+	Github           *bool `json:"github,omitempty"`
+	Instagram        bool  `json:"instagram"`
+	Name             bool  `json:"name"`
+	SelfIntroduction bool  `json:"self_introduction"`
+
+	// Website 省略時は非公開
+	Website *bool `json:"website,omitempty"`
+	X       bool  `json:"x"`
+}
+
+// GetApiAdminInvitationsParams defines parameters for GetApiAdminInvitations.
+type GetApiAdminInvitationsParams struct {
+	Status *InvitationStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiAdminMembersDuplicatesParams defines parameters for GetApiAdminMembersDuplicates.
+type GetApiAdminMembersDuplicatesParams struct {
+	// MinScore この値以上の組だけを返す
+	MinScore *float32 `form:"min_score,omitempty" json:"min_score,omitempty"`
+}
+
+// GetApiAuthProviderCallbackParams defines parameters for GetApiAuthProviderCallback.
+type GetApiAuthProviderCallbackParams struct {
+	// Code 認可コード
+	Code string `form:"code" json:"code"`
+
+	// State CSRF対策のstate値
+	State *string `form:"state,omitempty" json:"state,omitempty"`
 }
 
 // GetApiLineOauthParams defines parameters for GetApiLineOauth.
@@ -151,32 +539,263 @@ type GetApiLineOauthParams struct {
 	State *string `form:"state,omitempty" json:"state,omitempty"`
 }
 
+// GetApiMeLinksDiscordCallbackParams defines parameters for GetApiMeLinksDiscordCallback.
+type GetApiMeLinksDiscordCallbackParams struct {
+	// Code Discord OAuth認可コード
+	Code string `form:"code" json:"code"`
+
+	// State CSRF対策のstate値
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetApiMeLinksGithubCallbackParams defines parameters for GetApiMeLinksGithubCallback.
+type GetApiMeLinksGithubCallbackParams struct {
+	// Code GitHub OAuth認可コード
+	Code string `form:"code" json:"code"`
+
+	// State CSRF対策のstate値
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetApiMeLinksGithubStartParams defines parameters for GetApiMeLinksGithubStart.
+type GetApiMeLinksGithubStartParams struct {
+	// ShowOnProfile 連携後、GitHubのプロフィールURLをメンバー詳細のlinksに自動で載せるか
+	ShowOnProfile *bool `form:"show_on_profile,omitempty" json:"show_on_profile,omitempty"`
+}
+
+// GetApiMembersParams defines parameters for GetApiMembers.
+type GetApiMembersParams struct {
+	// Status 在籍状況で絞り込む
+	Status *MemberStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tag スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetApiMembersExportParams defines parameters for GetApiMembersExport.
+type GetApiMembersExportParams struct {
+	Format *GetApiMembersExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Columns 書き出す列（カンマ区切り、指定した順に並ぶ）
+	Columns *[]GetApiMembersExportParamsColumns `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// IncludeSensitive true のときだけ student_id・email の列を書き出せる
+	IncludeSensitive *bool `form:"include_sensitive,omitempty" json:"include_sensitive,omitempty"`
+
+	// Status 在籍状況で絞り込む（省略時は全員）
+	Status *MemberStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Role 班・役職（名前かID）で絞り込む
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Tag スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// EnrollmentYear 入学年度で絞り込む
+	EnrollmentYear *int `form:"enrollment_year,omitempty" json:"enrollment_year,omitempty"`
+}
+
+// GetApiMembersExportParamsFormat defines parameters for GetApiMembersExport.
+type GetApiMembersExportParamsFormat string
+
+// GetApiMembersExportParamsColumns defines parameters for GetApiMembersExport.
+type GetApiMembersExportParamsColumns string
+
+// PostApiMembersImportParams defines parameters for PostApiMembersImport.
+type PostApiMembersImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetApiMembersSearchParams defines parameters for GetApiMembersSearch.
+type GetApiMembersSearchParams struct {
+	// Q 検索語
+	Q     string `form:"q" json:"q"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiMembersIdSimilarParams defines parameters for GetApiMembersIdSimilar.
+type GetApiMembersIdSimilarParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiTeamsParams defines parameters for GetApiTeams.
+type GetApiTeamsParams struct {
+	Kind *TeamKind `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// PostApiAdminInvitationsJSONRequestBody defines body for PostApiAdminInvitations for application/json ContentType.
+type PostApiAdminInvitationsJSONRequestBody = InvitationCreate
+
+// PostApiAdminMembersIdMergeJSONRequestBody defines body for PostApiAdminMembersIdMerge for application/json ContentType.
+type PostApiAdminMembersIdMergeJSONRequestBody = MemberMerge
+
+// PutApiAdminMembersIdStatusJSONRequestBody defines body for PutApiAdminMembersIdStatus for application/json ContentType.
+type PutApiAdminMembersIdStatusJSONRequestBody = MemberStatusUpdate
+
+// PutApiMeSocialJSONRequestBody defines body for PutApiMeSocial for application/json ContentType.
+type PutApiMeSocialJSONRequestBody = SocialProfile
+
+// PutApiMeTagsJSONRequestBody defines body for PutApiMeTags for application/json ContentType.
+type PutApiMeTagsJSONRequestBody = TagsUpdate
+
 // PostApiMembersJSONRequestBody defines body for PostApiMembers for application/json ContentType.
 type PostApiMembersJSONRequestBody = MemberCreate
 
+// PostApiMembersIdTermsJSONRequestBody defines body for PostApiMembersIdTerms for application/json ContentType.
+type PostApiMembersIdTermsJSONRequestBody = RoleTermCreate
+
 // PutApiProfileBasicInfoJSONRequestBody defines body for PutApiProfileBasicInfo for application/json ContentType.
 type PutApiProfileBasicInfoJSONRequestBody = BasicInfo
 
+// PostApiTeamsJSONRequestBody defines body for PostApiTeams for application/json ContentType.
+type PostApiTeamsJSONRequestBody = TeamInput
+
+// PutApiTeamsIdJSONRequestBody defines body for PutApiTeamsId for application/json ContentType.
+type PutApiTeamsIdJSONRequestBody = TeamInput
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// 招待コードの一覧を取得する（役員のみ）
+	// (GET /api/admin/invitations)
+	GetApiAdminInvitations(c *gin.Context, params GetApiAdminInvitationsParams)
+	// 招待コードを発行する（役員のみ）
+	// (POST /api/admin/invitations)
+	PostApiAdminInvitations(c *gin.Context)
+	// 削除済みのメンバーを取得する（役員のみ）
+	// (GET /api/admin/members/deleted)
+	GetApiAdminMembersDeleted(c *gin.Context)
+	// 重複していそうなメンバーを取得する（役員のみ）
+	// (GET /api/admin/members/duplicates)
+	GetApiAdminMembersDuplicates(c *gin.Context, params GetApiAdminMembersDuplicatesParams)
+	// 承認待ちのメンバーを取得する（役員のみ）
+	// (GET /api/admin/members/pending)
+	GetApiAdminMembersPending(c *gin.Context)
+	// メンバーを削除する（役員のみ）
+	// (DELETE /api/admin/members/{id})
+	DeleteApiAdminMembersId(c *gin.Context, id string)
+	// 承認待ちのメンバーを承認する（役員のみ）
+	// (POST /api/admin/members/{id}/approve)
+	PostApiAdminMembersIdApprove(c *gin.Context, id string)
+	// 重複したメンバーを統合する（役員のみ）
+	// (POST /api/admin/members/{id}/merge)
+	PostApiAdminMembersIdMerge(c *gin.Context, id string)
+	// 承認待ちのメンバーを却下する（役員のみ）
+	// (POST /api/admin/members/{id}/reject)
+	PostApiAdminMembersIdReject(c *gin.Context, id string)
+	// 削除したメンバーを元に戻す（役員のみ）
+	// (POST /api/admin/members/{id}/restore)
+	PostApiAdminMembersIdRestore(c *gin.Context, id string)
+	// メンバーのステータスを変更する（役員のみ）
+	// (PUT /api/admin/members/{id}/status)
+	PutApiAdminMembersIdStatus(c *gin.Context, id string)
+	// 外部IDプロバイダのコールバック
+	// (GET /api/auth/{provider}/callback)
+	GetApiAuthProviderCallback(c *gin.Context, provider string, params GetApiAuthProviderCallbackParams)
+	// 外部IDプロバイダでのログインを開始する
+	// (GET /api/auth/{provider}/start)
+	GetApiAuthProviderStart(c *gin.Context, provider string)
+	// LINEログインを開始する
+	// (GET /api/line-login)
+	GetApiLineLogin(c *gin.Context)
 	// LINE OAuthコールバック
 	// (GET /api/line-oauth)
 	GetApiLineOauth(c *gin.Context, params GetApiLineOauthParams)
+	// 退会して自分のデータを削除する
+	// (DELETE /api/me)
+	DeleteApiMe(c *gin.Context)
+	// 自分のデータをまとめてダウンロードする
+	// (GET /api/me/export)
+	GetApiMeExport(c *gin.Context)
+	// Discordアカウント連携を解除する
+	// (DELETE /api/me/links/discord)
+	DeleteApiMeLinksDiscord(c *gin.Context)
+	// Discordアカウント連携のコールバック
+	// (GET /api/me/links/discord/callback)
+	GetApiMeLinksDiscordCallback(c *gin.Context, params GetApiMeLinksDiscordCallbackParams)
+	// Discordアカウント連携を開始する
+	// (GET /api/me/links/discord/start)
+	GetApiMeLinksDiscordStart(c *gin.Context)
+	// GitHubアカウント連携を解除する
+	// (DELETE /api/me/links/github)
+	DeleteApiMeLinksGithub(c *gin.Context)
+	// GitHubアカウント連携のコールバック
+	// (GET /api/me/links/github/callback)
+	GetApiMeLinksGithubCallback(c *gin.Context, params GetApiMeLinksGithubCallbackParams)
+	// GitHubアカウント連携を開始する
+	// (GET /api/me/links/github/start)
+	GetApiMeLinksGithubStart(c *gin.Context, params GetApiMeLinksGithubStartParams)
+	// 自分のSNSアカウント設定を取得する
+	// (GET /api/me/social)
+	GetApiMeSocial(c *gin.Context)
+	// 自分のSNSアカウント設定を更新する
+	// (PUT /api/me/social)
+	PutApiMeSocial(c *gin.Context)
+	// 自分のスキル・興味タグを更新する
+	// (PUT /api/me/tags)
+	PutApiMeTags(c *gin.Context)
 	// メンバー一覧を取得する
 	// (GET /api/members)
-	GetApiMembers(c *gin.Context)
+	GetApiMembers(c *gin.Context, params GetApiMembersParams)
 	// メンバーを登録する
 	// (POST /api/members)
 	PostApiMembers(c *gin.Context)
+	// メンバー名簿を書き出す（役員のみ）
+	// (GET /api/members/export)
+	GetApiMembersExport(c *gin.Context, params GetApiMembersExportParams)
+	// CSVからメンバーをまとめて登録する（役員のみ）
+	// (POST /api/members/import)
+	PostApiMembersImport(c *gin.Context, params PostApiMembersImportParams)
+	// メンバーを検索する
+	// (GET /api/members/search)
+	GetApiMembersSearch(c *gin.Context, params GetApiMembersSearchParams)
 	// メンバー詳細を取得する
 	// (GET /api/members/{id})
 	GetApiMembersId(c *gin.Context, id string)
+	// 興味の近いメンバーを取得する
+	// (GET /api/members/{id}/similar)
+	GetApiMembersIdSimilar(c *gin.Context, id string, params GetApiMembersIdSimilarParams)
+	// メンバーの任期を記録する（役員のみ）
+	// (POST /api/members/{id}/terms)
+	PostApiMembersIdTerms(c *gin.Context, id string)
+	// 任期の記録を削除する（役員のみ）
+	// (DELETE /api/members/{id}/terms/{termId})
+	DeleteApiMembersIdTermsTermId(c *gin.Context, id string, termId string)
+	// メンバーの連絡先（vCard）を取得する
+	// (GET /api/members/{id}/vcard)
+	GetApiMembersIdVcard(c *gin.Context, id string)
 	// 基本情報を取得する
 	// (GET /api/profile/basic-info)
 	GetApiProfileBasicInfo(c *gin.Context)
 	// 基本情報を更新する
 	// (PUT /api/profile/basic-info)
 	PutApiProfileBasicInfo(c *gin.Context)
+	// スキル・興味タグの一覧を取得する
+	// (GET /api/tags)
+	GetApiTags(c *gin.Context)
+	// 班・役職の一覧を取得する
+	// (GET /api/teams)
+	GetApiTeams(c *gin.Context, params GetApiTeamsParams)
+	// 班・役職を追加する（役員のみ）
+	// (POST /api/teams)
+	PostApiTeams(c *gin.Context)
+	// 班・役職を削除する（役員のみ）
+	// (DELETE /api/teams/{id})
+	DeleteApiTeamsId(c *gin.Context, id string)
+	// 班・役職を取得する
+	// (GET /api/teams/{id})
+	GetApiTeamsId(c *gin.Context, id string)
+	// 班・役職を更新する（役員のみ）
+	// (PUT /api/teams/{id})
+	PutApiTeamsId(c *gin.Context, id string)
+	// 役職の歴代就任者を取得する
+	// (GET /api/teams/{id}/holders)
+	GetApiTeamsIdHolders(c *gin.Context, id string)
+	// 班・役職に所属するメンバーを取得する
+	// (GET /api/teams/{id}/members)
+	GetApiTeamsIdMembers(c *gin.Context, id string)
+	// 班・役職のメンバーの連絡先をまとめて取得する
+	// (GET /api/teams/{id}/vcard)
+	GetApiTeamsIdVcard(c *gin.Context, id string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -188,37 +807,54 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// GetApiLineOauth operation middleware
-func (siw *ServerInterfaceWrapper) GetApiLineOauth(c *gin.Context) {
+// GetApiAdminInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminInvitations(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiLineOauthParams
+	c.Set(CookieAuthScopes, []string{"officer"})
 
-	// ------------- Required query parameter "code" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAdminInvitationsParams
 
-	if paramValue := c.Query("code"); paramValue != "" {
+	// ------------- Optional query parameter "status" -------------
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "state" -------------
+	siw.Handler.GetApiAdminInvitations(c, params)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
-		return
+// PostApiAdminInvitations operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminInvitations(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.PostApiAdminInvitations(c)
+}
+
+// GetApiAdminMembersDeleted operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminMembersDeleted(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -226,11 +862,26 @@ func (siw *ServerInterfaceWrapper) GetApiLineOauth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiLineOauth(c, params)
+	siw.Handler.GetApiAdminMembersDeleted(c)
 }
 
-// GetApiMembers operation middleware
-func (siw *ServerInterfaceWrapper) GetApiMembers(c *gin.Context) {
+// GetApiAdminMembersDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminMembersDuplicates(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAdminMembersDuplicatesParams
+
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", c.Request.URL.Query(), &params.MinScore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_score: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -239,11 +890,13 @@ func (siw *ServerInterfaceWrapper) GetApiMembers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiMembers(c)
+	siw.Handler.GetApiAdminMembersDuplicates(c, params)
 }
 
-// PostApiMembers operation middleware
-func (siw *ServerInterfaceWrapper) PostApiMembers(c *gin.Context) {
+// GetApiAdminMembersPending operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminMembersPending(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{"officer"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -252,11 +905,11 @@ func (siw *ServerInterfaceWrapper) PostApiMembers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiMembers(c)
+	siw.Handler.GetApiAdminMembersPending(c)
 }
 
-// GetApiMembersId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiMembersId(c *gin.Context) {
+// DeleteApiAdminMembersId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminMembersId(c *gin.Context) {
 
 	var err error
 
@@ -269,6 +922,8 @@ func (siw *ServerInterfaceWrapper) GetApiMembersId(c *gin.Context) {
 		return
 	}
 
+	c.Set(CookieAuthScopes, []string{"officer"})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -276,24 +931,24 @@ func (siw *ServerInterfaceWrapper) GetApiMembersId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiMembersId(c, id)
+	siw.Handler.DeleteApiAdminMembersId(c, id)
 }
 
-// GetApiProfileBasicInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetApiProfileBasicInfo(c *gin.Context) {
+// PostApiAdminMembersIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminMembersIdApprove(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.GetApiProfileBasicInfo(c)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// PutApiProfileBasicInfo operation middleware
-func (siw *ServerInterfaceWrapper) PutApiProfileBasicInfo(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -302,7 +957,1037 @@ func (siw *ServerInterfaceWrapper) PutApiProfileBasicInfo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutApiProfileBasicInfo(c)
+	siw.Handler.PostApiAdminMembersIdApprove(c, id)
+}
+
+// PostApiAdminMembersIdMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminMembersIdMerge(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminMembersIdMerge(c, id)
+}
+
+// PostApiAdminMembersIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminMembersIdReject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminMembersIdReject(c, id)
+}
+
+// PostApiAdminMembersIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminMembersIdRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminMembersIdRestore(c, id)
+}
+
+// PutApiAdminMembersIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminMembersIdStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminMembersIdStatus(c, id)
+}
+
+// GetApiAuthProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthProviderCallback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAuthProviderCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAuthProviderCallback(c, provider, params)
+}
+
+// GetApiAuthProviderStart operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthProviderStart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAuthProviderStart(c, provider)
+}
+
+// GetApiLineLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLineLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiLineLogin(c)
+}
+
+// GetApiLineOauth operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLineOauth(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiLineOauthParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiLineOauth(c, params)
+}
+
+// DeleteApiMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMe(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiMe(c)
+}
+
+// GetApiMeExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeExport(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeExport(c)
+}
+
+// DeleteApiMeLinksDiscord operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMeLinksDiscord(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiMeLinksDiscord(c)
+}
+
+// GetApiMeLinksDiscordCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeLinksDiscordCallback(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeLinksDiscordCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeLinksDiscordCallback(c, params)
+}
+
+// GetApiMeLinksDiscordStart operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeLinksDiscordStart(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeLinksDiscordStart(c)
+}
+
+// DeleteApiMeLinksGithub operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMeLinksGithub(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiMeLinksGithub(c)
+}
+
+// GetApiMeLinksGithubCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeLinksGithubCallback(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeLinksGithubCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeLinksGithubCallback(c, params)
+}
+
+// GetApiMeLinksGithubStart operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeLinksGithubStart(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMeLinksGithubStartParams
+
+	// ------------- Optional query parameter "show_on_profile" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "show_on_profile", c.Request.URL.Query(), &params.ShowOnProfile)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter show_on_profile: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeLinksGithubStart(c, params)
+}
+
+// GetApiMeSocial operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMeSocial(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMeSocial(c)
+}
+
+// PutApiMeSocial operation middleware
+func (siw *ServerInterfaceWrapper) PutApiMeSocial(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiMeSocial(c)
+}
+
+// PutApiMeTags operation middleware
+func (siw *ServerInterfaceWrapper) PutApiMeTags(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiMeTags(c)
+}
+
+// GetApiMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMembersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembers(c, params)
+}
+
+// PostApiMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMembers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMembers(c)
+}
+
+// GetApiMembersExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembersExport(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMembersExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "columns", c.Request.URL.Query(), &params.Columns)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter columns: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_sensitive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_sensitive", c.Request.URL.Query(), &params.IncludeSensitive)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_sensitive: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "enrollment_year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enrollment_year", c.Request.URL.Query(), &params.EnrollmentYear)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enrollment_year: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembersExport(c, params)
+}
+
+// PostApiMembersImport operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMembersImport(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiMembersImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMembersImport(c, params)
+}
+
+// GetApiMembersSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembersSearch(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMembersSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembersSearch(c, params)
+}
+
+// GetApiMembersId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembersId(c, id)
+}
+
+// GetApiMembersIdSimilar operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembersIdSimilar(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiMembersIdSimilarParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembersIdSimilar(c, id, params)
+}
+
+// PostApiMembersIdTerms operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMembersIdTerms(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiMembersIdTerms(c, id)
+}
+
+// DeleteApiMembersIdTermsTermId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiMembersIdTermsTermId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "termId" -------------
+	var termId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "termId", c.Param("termId"), &termId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter termId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiMembersIdTermsTermId(c, id, termId)
+}
+
+// GetApiMembersIdVcard operation middleware
+func (siw *ServerInterfaceWrapper) GetApiMembersIdVcard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiMembersIdVcard(c, id)
+}
+
+// GetApiProfileBasicInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfileBasicInfo(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfileBasicInfo(c)
+}
+
+// PutApiProfileBasicInfo operation middleware
+func (siw *ServerInterfaceWrapper) PutApiProfileBasicInfo(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiProfileBasicInfo(c)
+}
+
+// GetApiTags operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTags(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTags(c)
+}
+
+// GetApiTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeams(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiTeamsParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeams(c, params)
+}
+
+// PostApiTeams operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTeams(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiTeams(c)
+}
+
+// DeleteApiTeamsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiTeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiTeamsId(c, id)
+}
+
+// GetApiTeamsId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsId(c, id)
+}
+
+// PutApiTeamsId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiTeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{"officer"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiTeamsId(c, id)
+}
+
+// GetApiTeamsIdHolders operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsIdHolders(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsIdHolders(c, id)
+}
+
+// GetApiTeamsIdMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsIdMembers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsIdMembers(c, id)
+}
+
+// GetApiTeamsIdVcard operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTeamsIdVcard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTeamsIdVcard(c, id)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -332,10 +2017,51 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/admin/invitations", wrapper.GetApiAdminInvitations)
+	router.POST(options.BaseURL+"/api/admin/invitations", wrapper.PostApiAdminInvitations)
+	router.GET(options.BaseURL+"/api/admin/members/deleted", wrapper.GetApiAdminMembersDeleted)
+	router.GET(options.BaseURL+"/api/admin/members/duplicates", wrapper.GetApiAdminMembersDuplicates)
+	router.GET(options.BaseURL+"/api/admin/members/pending", wrapper.GetApiAdminMembersPending)
+	router.DELETE(options.BaseURL+"/api/admin/members/:id", wrapper.DeleteApiAdminMembersId)
+	router.POST(options.BaseURL+"/api/admin/members/:id/approve", wrapper.PostApiAdminMembersIdApprove)
+	router.POST(options.BaseURL+"/api/admin/members/:id/merge", wrapper.PostApiAdminMembersIdMerge)
+	router.POST(options.BaseURL+"/api/admin/members/:id/reject", wrapper.PostApiAdminMembersIdReject)
+	router.POST(options.BaseURL+"/api/admin/members/:id/restore", wrapper.PostApiAdminMembersIdRestore)
+	router.PUT(options.BaseURL+"/api/admin/members/:id/status", wrapper.PutApiAdminMembersIdStatus)
+	router.GET(options.BaseURL+"/api/auth/:provider/callback", wrapper.GetApiAuthProviderCallback)
+	router.GET(options.BaseURL+"/api/auth/:provider/start", wrapper.GetApiAuthProviderStart)
+	router.GET(options.BaseURL+"/api/line-login", wrapper.GetApiLineLogin)
 	router.GET(options.BaseURL+"/api/line-oauth", wrapper.GetApiLineOauth)
+	router.DELETE(options.BaseURL+"/api/me", wrapper.DeleteApiMe)
+	router.GET(options.BaseURL+"/api/me/export", wrapper.GetApiMeExport)
+	router.DELETE(options.BaseURL+"/api/me/links/discord", wrapper.DeleteApiMeLinksDiscord)
+	router.GET(options.BaseURL+"/api/me/links/discord/callback", wrapper.GetApiMeLinksDiscordCallback)
+	router.GET(options.BaseURL+"/api/me/links/discord/start", wrapper.GetApiMeLinksDiscordStart)
+	router.DELETE(options.BaseURL+"/api/me/links/github", wrapper.DeleteApiMeLinksGithub)
+	router.GET(options.BaseURL+"/api/me/links/github/callback", wrapper.GetApiMeLinksGithubCallback)
+	router.GET(options.BaseURL+"/api/me/links/github/start", wrapper.GetApiMeLinksGithubStart)
+	router.GET(options.BaseURL+"/api/me/social", wrapper.GetApiMeSocial)
+	router.PUT(options.BaseURL+"/api/me/social", wrapper.PutApiMeSocial)
+	router.PUT(options.BaseURL+"/api/me/tags", wrapper.PutApiMeTags)
 	router.GET(options.BaseURL+"/api/members", wrapper.GetApiMembers)
 	router.POST(options.BaseURL+"/api/members", wrapper.PostApiMembers)
+	router.GET(options.BaseURL+"/api/members/export", wrapper.GetApiMembersExport)
+	router.POST(options.BaseURL+"/api/members/import", wrapper.PostApiMembersImport)
+	router.GET(options.BaseURL+"/api/members/search", wrapper.GetApiMembersSearch)
 	router.GET(options.BaseURL+"/api/members/:id", wrapper.GetApiMembersId)
+	router.GET(options.BaseURL+"/api/members/:id/similar", wrapper.GetApiMembersIdSimilar)
+	router.POST(options.BaseURL+"/api/members/:id/terms", wrapper.PostApiMembersIdTerms)
+	router.DELETE(options.BaseURL+"/api/members/:id/terms/:termId", wrapper.DeleteApiMembersIdTermsTermId)
+	router.GET(options.BaseURL+"/api/members/:id/vcard", wrapper.GetApiMembersIdVcard)
 	router.GET(options.BaseURL+"/api/profile/basic-info", wrapper.GetApiProfileBasicInfo)
 	router.PUT(options.BaseURL+"/api/profile/basic-info", wrapper.PutApiProfileBasicInfo)
+	router.GET(options.BaseURL+"/api/tags", wrapper.GetApiTags)
+	router.GET(options.BaseURL+"/api/teams", wrapper.GetApiTeams)
+	router.POST(options.BaseURL+"/api/teams", wrapper.PostApiTeams)
+	router.DELETE(options.BaseURL+"/api/teams/:id", wrapper.DeleteApiTeamsId)
+	router.GET(options.BaseURL+"/api/teams/:id", wrapper.GetApiTeamsId)
+	router.PUT(options.BaseURL+"/api/teams/:id", wrapper.PutApiTeamsId)
+	router.GET(options.BaseURL+"/api/teams/:id/holders", wrapper.GetApiTeamsIdHolders)
+	router.GET(options.BaseURL+"/api/teams/:id/members", wrapper.GetApiTeamsIdMembers)
+	router.GET(options.BaseURL+"/api/teams/:id/vcard", wrapper.GetApiTeamsIdVcard)
 }
@@ -0,0 +1,49 @@
+// alumni は修業年限を終えた在籍中のメンバーを卒業生（alumni）に移すバッチ。
+// 年度が切り替わる 4 月に一度実行する想定。-dry-run で対象の確認だけができる。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "書き込まずに卒業生に移すメンバーを表示する")
+	flag.Parse()
+
+	ctx := context.Background()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("Config load error", "error", err)
+		os.Exit(1)
+	}
+
+	client, err := firestore.NewClient(ctx, cfg.Firestore.ProjectID, option.WithCredentialsFile(cfg.Firestore.Credentials))
+	if err != nil {
+		slog.Error("Firestore client error", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	now := time.Now()
+	graduates, err := service.NewMembersService(client).PromoteGraduates(ctx, now, *dryRun)
+	for _, m := range graduates {
+		fmt.Printf("%s\t%s\t%d年度入学\n", m.Id, m.Name, m.EnrollmentYear)
+	}
+	if err != nil {
+		slog.Error("Promote graduates error", "error", err, "promoted", len(graduates))
+		os.Exit(1)
+	}
+	slog.Info("Promote graduates done", "academic_year", service.AcademicYear(now), "count", len(graduates), "dry_run", *dryRun)
+}
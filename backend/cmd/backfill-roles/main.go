@@ -0,0 +1,57 @@
+// backfill-roles は teams コレクションができる前に登録されたメンバーに role_ids を設定するバッチ。
+// 班・役職を teams に登録してから一度実行する。-dry-run で対象の確認だけができる。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "書き込まずに role_ids を設定するメンバーを表示する")
+	flag.Parse()
+
+	ctx := context.Background()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("Config load error", "error", err)
+		os.Exit(1)
+	}
+
+	client, err := firestore.NewClient(ctx, cfg.Firestore.ProjectID, option.WithCredentialsFile(cfg.Firestore.Credentials))
+	if err != nil {
+		slog.Error("Firestore client error", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	teams, err := service.NewTeamsService(client).List(ctx)
+	if err != nil {
+		slog.Error("Teams list error", "error", err)
+		os.Exit(1)
+	}
+	updated, unresolved, err := service.NewMembersService(client).BackfillRoleIDs(ctx, teams, *dryRun)
+	for _, m := range updated {
+		fmt.Printf("%s\t%s\t%s\n", m.Id, m.Name, strings.Join(m.Roles, ","))
+	}
+	// 見つからない名前は班・役職を作ってから再実行する
+	for _, m := range unresolved {
+		slog.Warn("Unknown team names, skipped", "member", m.Id, "roles", m.Roles)
+	}
+	if err != nil {
+		slog.Error("Backfill role ids error", "error", err, "updated", len(updated))
+		os.Exit(1)
+	}
+	slog.Info("Backfill role ids done", "count", len(updated), "unresolved", len(unresolved), "dry_run", *dryRun)
+}
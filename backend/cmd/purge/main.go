@@ -0,0 +1,49 @@
+// purge は削除してから deleted_member_retention を過ぎたメンバーを完全に削除するバッチ。
+// 毎日一度実行する想定。-dry-run で対象の確認だけができる。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "書き込まずに完全に削除するメンバーを表示する")
+	flag.Parse()
+
+	ctx := context.Background()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("Config load error", "error", err)
+		os.Exit(1)
+	}
+
+	client, err := firestore.NewClient(ctx, cfg.Firestore.ProjectID, option.WithCredentialsFile(cfg.Firestore.Credentials))
+	if err != nil {
+		slog.Error("Firestore client error", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	before := time.Now().Add(-cfg.DeletedMemberRetention)
+	purged, err := service.NewMembersService(client).PurgeDeleted(ctx, before, *dryRun)
+	for _, m := range purged {
+		fmt.Printf("%s\t%s\t%s に削除\n", m.Id, m.Name, m.DeletedAt.Format(time.DateOnly))
+	}
+	if err != nil {
+		slog.Error("Purge deleted members error", "error", err, "purged", len(purged))
+		os.Exit(1)
+	}
+	slog.Info("Purge deleted members done", "retention", cfg.DeletedMemberRetention, "count", len(purged), "dry_run", *dryRun)
+}
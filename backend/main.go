@@ -9,33 +9,81 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/Lumos-Programming/profile-system-backend/api"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/handler"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/health"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/lifecycle"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/ratelimit"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"google.golang.org/api/option"
 )
 
 const (
-	authCookieName = "auth_token"
+	authCookieName = handler.AuthCookieName
 )
 
+// rateLimitGroups はレート制限をかけるルートグループと、その対象ルート（"METHOD /route/template"）。
+// 制限値は設定ファイルの rate_limits.<グループ名> で指定する。
+var rateLimitGroups = map[string][]string{
+	"auth": {
+		"GET /api/line-login", "GET /api/line-oauth", "POST /api/jwt/generate", "POST /api/dummy/auth",
+		"GET /api/auth/:provider/start", "GET /api/auth/:provider/callback",
+	},
+	"write": {
+		"POST /api/members", "POST /api/members/import", "PUT /api/profile/basic-info",
+		"GET /api/me/links/discord/callback", "DELETE /api/me/links/discord",
+		"GET /api/me/links/github/callback", "DELETE /api/me/links/github",
+		"POST /api/admin/invitations",
+		"POST /api/admin/members/:id/approve", "POST /api/admin/members/:id/reject", "PUT /api/admin/members/:id/status",
+		"POST /api/admin/members/:id/merge", "DELETE /api/admin/members/:id", "POST /api/admin/members/:id/restore",
+		"POST /api/teams", "PUT /api/teams/:id", "DELETE /api/teams/:id",
+		"POST /api/members/:id/terms", "DELETE /api/members/:id/terms/:termId",
+		"PUT /api/me/tags",
+		"PUT /api/me/social",
+		"DELETE /api/me",
+	},
+}
+
 // jwtSecret は開発用の HMAC シークレットです。実運用では環境変数やシークレットマネージャで管理してください。
 var jwtSecret = []byte("dummy_secret")
 
 func main() {
 	ctx := context.Background()
 
+	// Cloud Logging で構造化ログとして扱えるよう JSON で出力する
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
 	cfg, err := config.Load()
 	if err != nil {
 		slog.Error("Config load error", "error", err)
 		os.Exit(1)
 	}
 
+	if cfg.Auth.JWTSecret == config.DevJWTSecret {
+		if !cfg.Auth.AllowDevSecret {
+			slog.Error("auth.jwt_secret is not set; set it, or set auth.allow_dev_secret for local development")
+			os.Exit(1)
+		}
+		slog.Warn("auth.jwt_secret is not set; using the development secret")
+	}
+	jwtSecret = []byte(cfg.Auth.JWTSecret)
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		slog.Error("Tracing setup error", "error", err)
+		os.Exit(1)
+	}
+
 	opts := option.WithCredentialsFile(cfg.Firestore.Credentials)
 	client, err := firestore.NewClient(ctx, cfg.Firestore.ProjectID, opts)
 	if err != nil {
@@ -43,7 +91,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	router := setupAPIServer(client, cfg)
+	lc := lifecycle.New(cfg.ShutdownDelay)
+	// 閉じる順は登録の逆：Firestore を閉じてから残りのスパンを送り切る
+	lc.OnClose("tracing", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return shutdownTracing(ctx)
+	})
+	lc.OnClose("firestore", client.Close)
+
+	router := setupAPIServer(client, cfg, lc)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Port),
@@ -61,26 +118,77 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	slog.Info("Shutdown Server...")
-	client.Close()
+	slog.Info("Shutdown Server...", "delay", cfg.ShutdownDelay, "timeout", cfg.ShutdownTimeout)
+
+	// readiness を落として待つ → 受付停止 → リクエストのドレイン → バックグラウンド処理の完了待ち → Firestore クライアントのクローズ
+	// 待つ時間は shutdown_timeout に含めない
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownDelay+cfg.ShutdownTimeout)
+	defer cancel()
+	if err := lc.Shutdown(shutdownCtx, srv); err != nil {
+		slog.Error("Server shutdown error", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Server exited")
 }
 
-func setupAPIServer(client *firestore.Client, cfg *config.Config) *gin.Engine {
-	membersSvc := service.NewMembersService(client)
-	h := handler.NewHandler(client, cfg.LINE, membersSvc)
-	router := gin.Default()
+func setupAPIServer(client *firestore.Client, cfg *config.Config, lc *lifecycle.Lifecycle) *gin.Engine {
+	h := handler.NewHandler(client, cfg, handler.Services{
+		Members:     service.NewMembersService(client),
+		Identities:  service.NewIdentitiesService(client),
+		Invitations: service.NewInvitationsService(client),
+		Teams:       service.NewTeamsService(client),
+		Terms:       service.NewTermsService(client),
+	})
+	router := gin.New()
+	// IP 単位のレート制限とアクセスログは ClientIP を使うので、X-Forwarded-For は設定したプロキシからのものだけを信用する
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		slog.Error("Invalid trusted_proxies", "error", err)
+		os.Exit(1)
+	}
+	router.Use(
+		gin.Recovery(),
+		otelgin.Middleware(tracing.ServiceName(cfg.Tracing)),
+		logging.Middleware(slog.Default()),
+		metrics.Middleware(),
+		// セッション Cookie があれば user_id をセット（ユーザー単位のレート制限・ログに使う）
+		h.Session,
+	)
+	rateStore := ratelimit.NewMemoryStore()
+	lc.Go(func(ctx context.Context) {
+		rateStore.Run(ctx, time.Minute, 10*time.Minute)
+	})
+	router.Use(ratelimit.Middleware(rateStore, rateLimitRules(cfg.RateLimits)...))
 	router.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "http://localhost:3000")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, "+logging.RequestIDHeader)
+		c.Header("Access-Control-Expose-Headers", logging.RequestIDHeader+", Retry-After")
+		c.Header("Access-Control-Allow-Credentials", "true")
 	})
-	api.RegisterHandlers(router, h)
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"status": "ok",
-		})
+	// cookieAuth が指定されたエンドポイントはログイン必須
+	api.RegisterHandlersWithOptions(router, h, api.GinServerOptions{
+		Middlewares: []api.MiddlewareFunc{h.RequireAuth},
 	})
 
+	// /healthz はプロセスの生存確認、/readyz は依存先を含めてトラフィックを受けられるかの確認
+	checker := health.NewChecker(cfg.ReadinessTimeout)
+	checker.Add("shutdown", func(ctx context.Context) error {
+		// シャットダウン中は新しいトラフィックを受けないよう失敗を返す
+		if lc.Draining() {
+			return errors.New("server is draining")
+		}
+		return nil
+	})
+	checker.Add("config", func(ctx context.Context) error {
+		return cfg.Validate()
+	})
+	checker.Add("firestore", health.FirestoreCheck(client))
+	router.GET("/healthz", health.LivenessHandler)
+	router.GET("/readyz", checker.ReadinessHandler)
+	// 互換用：旧エンドポイント
+	router.GET("/health", health.LivenessHandler)
+	router.GET("/metrics", metrics.Handler())
+
 	api := router.Group("/api") // 以下のapiグループをまとめる
 
 	//api.Use(authHandler)
@@ -103,27 +211,9 @@ func setupAPIServer(client *firestore.Client, cfg *config.Config) *gin.Engine {
 		// 本番では秘密鍵を安全に管理し、ペイロードも適切に設定してください
 		// ここでは簡易なダミーペイロードと署名で生成します
 		// 例: github.com/golang-jwt/jwt/v5 を利用
-		type Claims struct {
-			UserID string `json:"user_id"`
-			jwt.RegisteredClaims
-		}
-
 		// ダミーユーザーID
-		userID := "dummy_user_id"
-
-		claims := Claims{
-			UserID:           userID,
-			RegisteredClaims: jwt.RegisteredClaims{
-				// 有効期限など必要に応じて設定
-			},
-		}
-
-		// シークレットキー（本番では環境変数などで管理）
-		secret := []byte("dummy_secret")
-
-		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-		tokenString, err := token.SignedString(secret)
-		if err != nil {
+		tokenString := generateDummyJWT("dummy_user_id")
+		if tokenString == "" {
 			c.JSON(500, gin.H{"error": "failed to generate token"})
 			return
 		}
@@ -151,11 +241,50 @@ func setupAPIServer(client *firestore.Client, cfg *config.Config) *gin.Engine {
 	return router
 }
 
+// rateLimitRules は設定から rateLimitGroups の各グループに対応する制限ルールを作る。
+func rateLimitRules(limits map[string]config.RateLimit) []ratelimit.Rule {
+	rules := make([]ratelimit.Rule, 0, len(rateLimitGroups))
+	for name, routes := range rateLimitGroups {
+		rl, ok := limits[name]
+		if !ok || rl.RequestsPerMinute <= 0 {
+			continue
+		}
+		key := ratelimit.ByIP
+		if rl.Key == "user" {
+			key = ratelimit.ByUser
+		}
+		rules = append(rules, ratelimit.Rule{
+			Name:   name,
+			Routes: routes,
+			Limit:  ratelimit.PerMinute(rl.RequestsPerMinute, max(rl.Burst, 1)),
+			Key:    key,
+		})
+	}
+	return rules
+}
+
 type Claims struct {
 	UserID string `json:"user_id"`
 	jwt.RegisteredClaims
 }
 
+// generateDummyJWT は開発用シークレットで userID を埋め込んだ JWT を生成します。失敗時は空文字を返します。
+func generateDummyJWT(userID string) string {
+	claims := Claims{
+		UserID:           userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			// 有効期限など必要に応じて設定
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString(jwtSecret)
+	if err != nil {
+		return ""
+	}
+	return tokenString
+}
+
 // authHandler はJWTトークンを検証し、ユーザーIDをコンテキストにセットします。
 func authHandler(c *gin.Context) {
 	tokenString, err := c.Cookie(authCookieName)
@@ -1,16 +1,40 @@
 package config
 
 import (
+	"errors"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Port      int       `yaml:"port"`
-	Firestore Firestore `yaml:"firestore"`
-	LINE      LINE      `yaml:"line"`
+	Port int `yaml:"port"`
+	// ShutdownDelay は停止シグナル受信後、/readyz を失敗させたまま受付を続ける時間（ロードバランサが振り分けをやめるのを待つ）。
+	// 0 なら待たずに受付を止める
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+	// ShutdownTimeout は受付停止後、処理中リクエストとバックグラウンド処理の完了を待つ最大時間
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// ReadinessTimeout は /readyz で依存先 1 つあたりのチェックを打ち切る時間
+	ReadinessTimeout time.Duration `yaml:"readiness_timeout"`
+	// SearchIndexTTL はメンバー検索の索引を Firestore から作り直す間隔（メンバーの登録・変更時はすぐ作り直す）
+	SearchIndexTTL time.Duration `yaml:"search_index_ttl"`
+	// DeletedMemberRetention は削除したメンバーを復元できるように残しておく期間。過ぎたら purge バッチで完全に削除する
+	DeletedMemberRetention time.Duration `yaml:"deleted_member_retention"`
+	Firestore              Firestore     `yaml:"firestore"`
+	LINE                   LINE          `yaml:"line"`
+	Tracing                Tracing       `yaml:"tracing"`
+	// TrustedProxies はクライアント IP を X-Forwarded-For から読んでよいプロキシ（IP または CIDR）。
+	// 空なら X-Forwarded-For を信用せず、接続元の IP をクライアント IP として扱う
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// RateLimits はルートグループ名（auth / write など）ごとのレート制限
+	RateLimits map[string]RateLimit `yaml:"rate_limits"`
+	Auth       Auth                 `yaml:"auth"`
+	Discord    OAuthProvider        `yaml:"discord"`
+	GitHub     OAuthProvider        `yaml:"github"`
+	// IdentityProviders は LINE 以外のログイン手段（キーがプロバイダ名、/api/auth/{provider} の provider になる）
+	IdentityProviders map[string]IdentityProvider `yaml:"identity_providers"`
 }
 
 type Firestore struct {
@@ -22,15 +46,94 @@ type LINE struct {
 	ChannelID     string `yaml:"channel_id"`
 	ChannelSecret string `yaml:"channel_secret"`
 	RedirectURI   string `yaml:"redirect_uri"`
+	// AuthRedirectURI は /api/auth/line/callback のリダイレクト URI。空なら RedirectURI（/api/line-oauth）を使う
+	AuthRedirectURI string `yaml:"auth_redirect_uri"`
+	// APIBaseURL は LINE API のベース URL。空なら https://api.line.me（テストではスタブサーバに向ける）
+	APIBaseURL string `yaml:"api_base_url"`
+	// AuthorizeURL は LINE の認可画面の URL。空なら https://access.line.me/oauth2/v2.1/authorize
+	AuthorizeURL string `yaml:"authorize_url"`
+	// MaxRetries は LINE の 5xx・通信エラー時のリトライ回数（トークン交換はリトライしない）。
+	// 省略時はデフォルト（2 回）、0 以下ならリトライしない
+	MaxRetries *int `yaml:"max_retries"`
+}
+
+// Tracing は OpenTelemetry のトレース出力設定。
+// Exporter が空（または "none"）の場合はトレースを出力しない（テストやローカルではコレクタ不要）。
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"` // "none" | "otlp"
+	Endpoint    string  `yaml:"endpoint"` // OTLP/HTTP の送信先（例: localhost:4318）
+	Insecure    bool    `yaml:"insecure"`
+	ServiceName string  `yaml:"service_name"`
+	SampleRatio float64 `yaml:"sample_ratio"` // 0 の場合は全件サンプリング
+}
+
+// RateLimit は 1 つのルートグループに対するトークンバケットの設定。
+type RateLimit struct {
+	RequestsPerMinute int    `yaml:"requests_per_minute"`
+	Burst             int    `yaml:"burst"`
+	Key               string `yaml:"key"` // "ip" | "user"
 }
 
 // type authを作成し、secretフィールドを追加
 type Auth struct {
 	JWTSecret string `yaml:"jwt_secret"`
+	// SessionTTL はログイン後に発行するセッション（auth_token Cookie）の有効期間
+	SessionTTL time.Duration `yaml:"session_ttl"`
+	// OfficerRoles はいずれかを持つメンバーを役員（招待コードの発行など管理操作ができる）とみなす役職
+	OfficerRoles []string `yaml:"officer_roles"`
+	// AllowDevSecret が true のときだけ、jwt_secret 未設定で開発用シークレットを使って起動できる（ローカル開発用）
+	AllowDevSecret bool `yaml:"allow_dev_secret"`
+}
+
+// OAuthProvider は Discord / GitHub などアカウント連携先の OAuth2 設定。
+// エンドポイントは空ならプロバイダ既定の URL を使う（テストではローカルのフェイクに向ける）。
+type OAuthProvider struct {
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	RedirectURI  string `yaml:"redirect_uri"`
+	AuthorizeURL string `yaml:"authorize_url"`
+	TokenURL     string `yaml:"token_url"`
+	APIBaseURL   string `yaml:"api_base_url"`
+}
+
+// IdentityProvider はログインに使う汎用の OAuth2 / OpenID Connect プロバイダの設定。
+// 設定を足すだけで /api/auth/{provider}/start からログインできるようになる。
+type IdentityProvider struct {
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURI  string   `yaml:"redirect_uri"`
+	AuthorizeURL string   `yaml:"authorize_url"`
+	TokenURL     string   `yaml:"token_url"`
+	UserInfoURL  string   `yaml:"userinfo_url"`
+	Scopes       []string `yaml:"scopes"`
+	// userinfo レスポンスのフィールド名。省略時は OIDC 標準の sub / email / name / picture
+	SubjectField string `yaml:"subject_field"`
+	EmailField   string `yaml:"email_field"`
+	NameField    string `yaml:"name_field"`
+	PictureField string `yaml:"picture_field"`
 }
 
 var configPath = "../secrets/config.yaml"
 
+const (
+	defaultShutdownTimeout  = 10 * time.Second
+	defaultReadinessTimeout = 2 * time.Second
+	defaultSearchIndexTTL   = 5 * time.Minute
+	defaultDeletedRetention = 30 * 24 * time.Hour
+	defaultSessionTTL       = 7 * 24 * time.Hour
+	// DevJWTSecret は auth.jwt_secret 未設定時に使う開発用シークレット。本番では必ず設定すること。
+	DevJWTSecret = "dummy_secret"
+)
+
+// auth.officer_roles 省略時の役員の役職
+var defaultOfficerRoles = []string{"代表", "副代表"}
+
+// 設定ファイルで省略されたグループに使うレート制限
+var defaultRateLimits = map[string]RateLimit{
+	"auth":  {RequestsPerMinute: 10, Burst: 5, Key: "ip"},
+	"write": {RequestsPerMinute: 30, Burst: 10, Key: "user"},
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 	envConfigPath := os.Getenv("CONFIG_PATH")
@@ -48,5 +151,53 @@ func Load() (*Config, error) {
 	if err := yaml.NewDecoder(file).Decode(&config); err != nil {
 		return nil, err
 	}
+	if config.ShutdownTimeout == 0 {
+		config.ShutdownTimeout = defaultShutdownTimeout
+	}
+	if config.ReadinessTimeout == 0 {
+		config.ReadinessTimeout = defaultReadinessTimeout
+	}
+	if config.SearchIndexTTL == 0 {
+		config.SearchIndexTTL = defaultSearchIndexTTL
+	}
+	if config.DeletedMemberRetention == 0 {
+		config.DeletedMemberRetention = defaultDeletedRetention
+	}
+	if config.Auth.JWTSecret == "" {
+		config.Auth.JWTSecret = DevJWTSecret
+	}
+	if config.Auth.SessionTTL == 0 {
+		config.Auth.SessionTTL = defaultSessionTTL
+	}
+	if len(config.Auth.OfficerRoles) == 0 {
+		config.Auth.OfficerRoles = defaultOfficerRoles
+	}
+	if config.RateLimits == nil {
+		config.RateLimits = make(map[string]RateLimit)
+	}
+	for name, rl := range defaultRateLimits {
+		if _, ok := config.RateLimits[name]; !ok {
+			config.RateLimits[name] = rl
+		}
+	}
 	return &config, nil
 }
+
+// Validate はサーバがトラフィックを受けるのに必要な設定が揃っているかを確認する。
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Port == 0 {
+		errs = append(errs, errors.New("port is not set"))
+	}
+	if c.Firestore.ProjectID == "" {
+		errs = append(errs, errors.New("firestore.project_id is not set"))
+	}
+	if c.LINE.ChannelID == "" || c.LINE.ChannelSecret == "" || c.LINE.RedirectURI == "" {
+		errs = append(errs, errors.New("line.channel_id, line.channel_secret and line.redirect_uri are required"))
+	}
+	// 開発用シークレットは公開されているので、それで署名したセッションは誰でも偽造できる
+	if c.Auth.JWTSecret == DevJWTSecret && !c.Auth.AllowDevSecret {
+		errs = append(errs, errors.New("auth.jwt_secret is not set (set auth.allow_dev_secret to use the development secret)"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,49 @@
+// Package discord は Discord アカウントの連携に使う OAuth2 の設定を提供する。
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+)
+
+const (
+	DefaultAuthorizeURL = "https://discord.com/oauth2/authorize"
+	DefaultTokenURL     = "https://discord.com/api/oauth2/token"
+	DefaultAPIBaseURL   = "https://discord.com/api"
+)
+
+// user は /users/@me のレスポンスのうち使う項目。
+type user struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// NewClient は Discord のアカウント連携用クライアントを生成する。エンドポイントが空なら Discord の既定 URL を使う。
+func NewClient(cfg config.OAuthProvider, httpClient *http.Client) *identity.LinkClient {
+	return identity.NewLinkClient(cfg, identity.LinkConfig{
+		AuthorizeURL: DefaultAuthorizeURL,
+		TokenURL:     DefaultTokenURL,
+		APIBaseURL:   DefaultAPIBaseURL,
+		UserInfoPath: "/users/@me",
+		Scopes:       []string{"identify"},
+		AuthParams:   map[string]string{"prompt": "consent"},
+		Decode:       decodeUser,
+	}, httpClient)
+}
+
+// decodeUser は /users/@me のレスポンスを連携するアカウントにする。
+func decodeUser(body []byte) (*identity.Account, error) {
+	var u user
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("discord user fetch: %w", err)
+	}
+	if u.ID == "" {
+		return nil, errors.New("discord user fetch: empty user id")
+	}
+	return &identity.Account{ID: u.ID, Username: u.Username}, nil
+}
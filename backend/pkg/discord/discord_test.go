@@ -0,0 +1,70 @@
+package discord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeDiscord は Discord のトークン・ユーザー API を模したサーバを立てる。
+func newFakeDiscord(t *testing.T) *identity.LinkClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Form.Get("code") != "good" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant","error_description":"Invalid \"code\" in request."}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"at","token_type":"Bearer","expires_in":604800}`))
+	})
+	mux.HandleFunc("/api/users/@me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"id":"80351110224678912","username":"tanataro","global_name":"たなたろ"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClient(config.OAuthProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  "http://localhost:8080/api/me/links/discord/callback",
+		AuthorizeURL: srv.URL + "/oauth2/authorize",
+		TokenURL:     srv.URL + "/api/oauth2/token",
+		APIBaseURL:   srv.URL + "/api",
+	}, srv.Client())
+}
+
+func TestFetchAccount(t *testing.T) {
+	c := newFakeDiscord(t)
+
+	acc, err := c.FetchAccount(context.Background(), "good")
+	assert.NoError(t, err)
+	assert.Equal(t, "80351110224678912", acc.ID)
+	assert.Equal(t, "tanataro", acc.Username)
+
+	_, err = c.FetchAccount(context.Background(), "expired")
+	assert.ErrorIs(t, err, identity.ErrInvalidGrant)
+}
+
+func TestAuthorizeURL(t *testing.T) {
+	c := newFakeDiscord(t)
+
+	u, err := url.Parse(c.AuthorizeURL("state-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "state-1", u.Query().Get("state"))
+	assert.Equal(t, "identify", u.Query().Get("scope"))
+	assert.Equal(t, "client", u.Query().Get("client_id"))
+	assert.Equal(t, "consent", u.Query().Get("prompt"))
+}
@@ -0,0 +1,35 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// ArchiveContentType は WriteArchive で書き出す ZIP の Content-Type。
+const ArchiveContentType = "application/zip"
+
+// ArchiveFile は ZIP に入れる 1 ファイル。Data は JSON にして書く。
+type ArchiveFile struct {
+	Name string
+	Data any
+}
+
+// WriteArchive は files をそれぞれ整形した JSON ファイルにして、ZIP で w に書き出す。
+// modified は各ファイルの更新日時（書き出した日時）。
+func WriteArchive(w io.Writer, files []ArchiveFile, modified time.Time) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: f.Name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.Data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,106 @@
+// Package export はメンバー名簿を CSV・XLSX・JSON で書き出す。
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+)
+
+var (
+	// ErrUnknownColumn は存在しない列が指定されたことを表す。
+	ErrUnknownColumn = errors.New("unknown column")
+	// ErrSensitiveColumn は個人情報の列を明示的な許可なしに指定したことを表す。
+	ErrSensitiveColumn = errors.New("sensitive column requires include_sensitive")
+)
+
+// Column は名簿の 1 列。
+type Column struct {
+	// Key は API の columns や JSON のキーに使う名前
+	Key string
+	// Header は CSV・XLSX の見出し
+	Header string
+	// Sensitive が true の列（学籍番号など）は明示的に許可したときだけ書き出す
+	Sensitive bool
+	value     func(m *service.Member, now time.Time) string
+}
+
+// Value は m のこの列の値を返す。
+func (c Column) Value(m *service.Member, now time.Time) string {
+	return c.value(m, now)
+}
+
+// roleSeparator は班・役職、タグを 1 つのセルにまとめるときの区切り（一括登録の CSV と同じ）。
+const roleSeparator = ";"
+
+// columns は書き出せる列。順番は既定の並び順。
+var columns = []Column{
+	{Key: "id", Header: "ID", value: func(m *service.Member, _ time.Time) string { return m.Id }},
+	{Key: "name", Header: "名前", value: func(m *service.Member, _ time.Time) string { return m.Name }},
+	{Key: "nickname", Header: "ニックネーム", value: func(m *service.Member, _ time.Time) string { return m.Nickname }},
+	{Key: "student_id", Header: "学籍番号", Sensitive: true, value: func(m *service.Member, _ time.Time) string { return m.StudentID }},
+	{Key: "email", Header: "メールアドレス", Sensitive: true, value: func(m *service.Member, _ time.Time) string { return m.Email }},
+	{Key: "department", Header: "学部", value: func(m *service.Member, _ time.Time) string { return m.Department }},
+	{Key: "year", Header: "学年", value: func(m *service.Member, now time.Time) string { return m.DisplayYear(now) }},
+	{Key: "enrollment_year", Header: "入学年度", value: func(m *service.Member, _ time.Time) string {
+		if m.EnrollmentYear == 0 {
+			return ""
+		}
+		return strconv.Itoa(m.EnrollmentYear)
+	}},
+	{Key: "status", Header: "在籍状況", value: func(m *service.Member, _ time.Time) string { return m.EffectiveStatus() }},
+	{Key: "roles", Header: "役職", value: func(m *service.Member, _ time.Time) string { return strings.Join(m.Roles, roleSeparator) }},
+	{Key: "tags", Header: "タグ", value: func(m *service.Member, _ time.Time) string { return strings.Join(m.Tags, roleSeparator) }},
+	{Key: "created_at", Header: "登録日", value: func(m *service.Member, _ time.Time) string {
+		if m.CreatedAt.IsZero() {
+			return ""
+		}
+		return m.CreatedAt.In(jst).Format(time.DateOnly)
+	}},
+}
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// DefaultColumns は列の指定がないときに書き出す列（個人情報の列を除いたもの）を返す。
+func DefaultColumns() []Column {
+	out := make([]Column, 0, len(columns))
+	for _, c := range columns {
+		if !c.Sensitive {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// SelectColumns は keys の順に列を返す。keys が空なら DefaultColumns。
+// 存在しない列は ErrUnknownColumn、includeSensitive でないのに個人情報の列があれば ErrSensitiveColumn。
+func SelectColumns(keys []string, includeSensitive bool) ([]Column, error) {
+	if len(keys) == 0 {
+		return DefaultColumns(), nil
+	}
+	out := make([]Column, 0, len(keys))
+	for _, key := range keys {
+		c, ok := findColumn(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownColumn, key)
+		}
+		if c.Sensitive && !includeSensitive {
+			return nil, fmt.Errorf("%w: %s", ErrSensitiveColumn, key)
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func findColumn(key string) (Column, bool) {
+	for _, c := range columns {
+		if c.Key == key {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/xuri/excelize/v2"
+)
+
+var testMembers = []service.Member{
+	{Id: "m1", Name: "田中 太郎", StudentID: "24T0001", EnrollmentYear: 2025, Roles: []string{"Web班", "代表"}},
+	{Id: "m2", Name: "=HYPERLINK(\"x\")", Status: service.StatusAlumni},
+}
+
+var testNow = time.Date(2025, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func TestSelectColumns(t *testing.T) {
+	cols, err := SelectColumns(nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cols {
+		if c.Sensitive {
+			t.Fatalf("default columns should not include %s", c.Key)
+		}
+	}
+
+	cols, err = SelectColumns([]string{"year", "name"}, false)
+	if err != nil || len(cols) != 2 || cols[0].Key != "year" || cols[1].Key != "name" {
+		t.Fatalf("columns should keep the requested order: %+v, %v", cols, err)
+	}
+	if _, err := SelectColumns([]string{"name", "student_id"}, false); !errors.Is(err, ErrSensitiveColumn) {
+		t.Fatalf("expected ErrSensitiveColumn, got %v", err)
+	}
+	if _, err := SelectColumns([]string{"name", "student_id"}, true); err != nil {
+		t.Fatalf("sensitive columns should be allowed explicitly: %v", err)
+	}
+	if _, err := SelectColumns([]string{"password"}, true); !errors.Is(err, ErrUnknownColumn) {
+		t.Fatalf("expected ErrUnknownColumn, got %v", err)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	cols, _ := SelectColumns([]string{"name", "student_id", "year", "roles", "status"}, true)
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, cols, testMembers, testNow); err != nil {
+		t.Fatal(err)
+	}
+	want := "\ufeff名前,学籍番号,学年,役職,在籍状況\n" +
+		"田中 太郎,24T0001,1年生,Web班;代表,active\n" +
+		"\"'=HYPERLINK(\"\"x\"\")\",,卒業生,,alumni\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	cols, _ := SelectColumns([]string{"name", "enrollment_year"}, false)
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, cols, testMembers, testNow); err != nil {
+		t.Fatal(err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := f.GetRows(sheetName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[0][0] != "名前" || rows[1][1] != "2025" || rows[2][0] != "=HYPERLINK(\"x\")" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	cols, _ := SelectColumns([]string{"id", "roles"}, false)
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, cols, testMembers, testNow); err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json %q: %v", buf.String(), err)
+	}
+	if len(got) != 2 || got[0]["id"] != "m1" || got[0]["roles"] != "Web班;代表" || len(got[1]) != 2 {
+		t.Fatalf("unexpected objects: %v", got)
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, cols, nil, testNow); err != nil || strings.TrimSpace(buf.String()) != "[]" {
+		t.Fatalf("empty export should be an empty array: %q, %v", buf.String(), err)
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	var buf bytes.Buffer
+	files := []ArchiveFile{
+		{Name: "member.json", Data: map[string]any{"name": "田中 太郎"}},
+		{Name: "events.json", Data: []string{}},
+	}
+	if err := WriteArchive(&buf, files, testNow); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 2 || zr.File[0].Name != "member.json" || zr.File[1].Name != "events.json" {
+		t.Fatalf("unexpected files: %+v", zr.File)
+	}
+	f, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got map[string]string
+	if err := json.NewDecoder(f).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got["name"] != "田中 太郎" {
+		t.Fatalf("unexpected content: %v", got)
+	}
+}
@@ -0,0 +1,148 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/xuri/excelize/v2"
+)
+
+// 書き出し形式
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+	FormatJSON = "json"
+)
+
+// sheetName は XLSX のシート名
+const sheetName = "メンバー"
+
+// ContentType は format の Content-Type を返す。
+func ContentType(format string) string {
+	switch format {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatJSON:
+		return "application/json; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Write は members を format で w に書き出す。列は cols の順、学年は now の時点で計算する。
+func Write(w io.Writer, format string, cols []Column, members []service.Member, now time.Time) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, cols, members, now)
+	case FormatXLSX:
+		return WriteXLSX(w, cols, members, now)
+	case FormatJSON:
+		return WriteJSON(w, cols, members, now)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// WriteCSV は見出し行付きの CSV を書き出す。Excel で文字化けしないよう先頭に BOM を付ける。
+// 「=」などで始まる値は数式として解釈されないよう先頭に「'」を付ける。
+func WriteCSV(w io.Writer, cols []Column, members []service.Member, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString("\ufeff"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(bw)
+	record := make([]string, len(cols))
+	for i, c := range cols {
+		record[i] = c.Header
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for i := range members {
+		for j, c := range cols {
+			record[j] = escapeFormula(c.Value(&members[i], now))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func escapeFormula(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// WriteXLSX は見出し行付きの 1 シートの XLSX を書き出す。値はすべて文字列のセルにする。
+func WriteXLSX(w io.Writer, cols []Column, members []service.Member, now time.Time) error {
+	f := excelize.NewFile()
+	defer f.Close()
+	if err := f.SetSheetName("Sheet1", sheetName); err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		return err
+	}
+	row := make([]any, len(cols))
+	for i, c := range cols {
+		row[i] = c.Header
+	}
+	if err := sw.SetRow("A1", row); err != nil {
+		return err
+	}
+	for i := range members {
+		for j, c := range cols {
+			row[j] = c.Value(&members[i], now)
+		}
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		if err := sw.SetRow(cell, row); err != nil {
+			return err
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		return err
+	}
+	return f.Write(w)
+}
+
+// WriteJSON は列の Key をキーにしたオブジェクトの配列を書き出す。1 件ずつ書くので全体をメモリに持たない。
+func WriteJSON(w io.Writer, cols []Column, members []service.Member, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if _, err := bw.WriteString("["); err != nil {
+		return err
+	}
+	obj := make(map[string]string, len(cols))
+	for i := range members {
+		if i > 0 {
+			if _, err := bw.WriteString(","); err != nil {
+				return err
+			}
+		}
+		for _, c := range cols {
+			obj[c.Key] = c.Value(&members[i], now)
+		}
+		if err := enc.Encode(obj); err != nil {
+			return err
+		}
+	}
+	if _, err := bw.WriteString("]\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,55 @@
+// Package github は GitHub アカウントの連携に使う OAuth2 の設定を提供する。
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+)
+
+const (
+	DefaultAuthorizeURL = "https://github.com/login/oauth/authorize"
+	DefaultTokenURL     = "https://github.com/login/oauth/access_token"
+	DefaultAPIBaseURL   = "https://api.github.com"
+)
+
+// user は GET /user のレスポンスのうち使う項目。
+type user struct {
+	ID      int64  `json:"id"`
+	Login   string `json:"login"`
+	HTMLURL string `json:"html_url"`
+}
+
+// NewClient は GitHub のアカウント連携用クライアントを生成する。エンドポイントが空なら GitHub の既定 URL を使う。
+func NewClient(cfg config.OAuthProvider, httpClient *http.Client) *identity.LinkClient {
+	return identity.NewLinkClient(cfg, identity.LinkConfig{
+		AuthorizeURL: DefaultAuthorizeURL,
+		TokenURL:     DefaultTokenURL,
+		APIBaseURL:   DefaultAPIBaseURL,
+		UserInfoPath: "/user",
+		// 公開プロフィールだけ読めればよいのでスコープは最小にする
+		Scopes: []string{"read:user"},
+		Header: http.Header{"Accept": {"application/vnd.github+json"}},
+		Decode: decodeUser,
+	}, httpClient)
+}
+
+// decodeUser は GET /user のレスポンスを連携するアカウントにする。ID は Firestore に保存する形（文字列）にする。
+func decodeUser(body []byte) (*identity.Account, error) {
+	var u user
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("github user fetch: %w", err)
+	}
+	if u.ID == 0 || u.Login == "" {
+		return nil, errors.New("github user fetch: empty user")
+	}
+	if u.HTMLURL == "" {
+		u.HTMLURL = "https://github.com/" + u.Login
+	}
+	return &identity.Account{ID: strconv.FormatInt(u.ID, 10), Username: u.Login, ProfileURL: u.HTMLURL}, nil
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeGitHub は GitHub のトークン・ユーザー API を模したサーバを立てる。
+func newFakeGitHub(t *testing.T) *identity.LinkClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		// GitHub は既定でフォーム形式、エラーでも 200 を返す
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		if r.Form.Get("code") != "good" {
+			w.Write([]byte("error=bad_verification_code&error_description=The+code+passed+is+incorrect+or+expired."))
+			return
+		}
+		w.Write([]byte("access_token=gho_at&scope=read%3Auser&token_type=bearer"))
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gho_at" || r.Header.Get("Accept") != "application/vnd.github+json" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"id":583231,"login":"octocat","html_url":"https://github.com/octocat"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewClient(config.OAuthProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  "http://localhost:8080/api/me/links/github/callback",
+		AuthorizeURL: srv.URL + "/login/oauth/authorize",
+		TokenURL:     srv.URL + "/login/oauth/access_token",
+		APIBaseURL:   srv.URL,
+	}, srv.Client())
+}
+
+func TestFetchAccount(t *testing.T) {
+	c := newFakeGitHub(t)
+
+	acc, err := c.FetchAccount(context.Background(), "good")
+	assert.NoError(t, err)
+	assert.Equal(t, "583231", acc.ID)
+	assert.Equal(t, "octocat", acc.Username)
+	assert.Equal(t, "https://github.com/octocat", acc.ProfileURL)
+
+	_, err = c.FetchAccount(context.Background(), "expired")
+	assert.ErrorIs(t, err, identity.ErrInvalidGrant)
+}
+
+func TestAuthorizeURL(t *testing.T) {
+	c := newFakeGitHub(t)
+
+	u, err := url.Parse(c.AuthorizeURL("state-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "state-1", u.Query().Get("state"))
+	assert.Equal(t, "read:user", u.Query().Get("scope"))
+	assert.Equal(t, "client", u.Query().Get("client_id"))
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetApiAdminMembersPending は承認待ちのメンバーを登録が古い順に返す（役員のみ）。
+func (h *Handler) GetApiAdminMembersPending(c *gin.Context) {
+	members, err := h.membersSvc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	pending := make([]service.Member, 0)
+	for _, m := range members {
+		if m.EffectiveStatus() == service.StatusPending {
+			pending = append(pending, m)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].CreatedAt.Before(pending[j].CreatedAt) })
+
+	out := make([]api.MemberDetail, 0, len(pending))
+	for _, m := range pending {
+		out = append(out, m.ToDetail())
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// PostApiAdminMembersIdApprove は承認待ちのメンバーを在籍中にする（役員のみ）。
+func (h *Handler) PostApiAdminMembersIdApprove(c *gin.Context, id string) {
+	err := h.membersSvc.UpdateStatus(c.Request.Context(), id, []string{service.StatusPending}, service.StatusActive)
+	h.respondStatusChange(c, "member approved", id, err)
+}
+
+// PostApiAdminMembersIdReject は承認待ちのメンバーを削除する（役員のみ）。
+func (h *Handler) PostApiAdminMembersIdReject(c *gin.Context, id string) {
+	err := h.membersSvc.DeletePending(c.Request.Context(), id)
+	h.respondStatusChange(c, "member rejected", id, err)
+}
+
+// PutApiAdminMembersIdStatus は在籍中・卒業生・利用停止を切り替える（役員のみ）。
+func (h *Handler) PutApiAdminMembersIdStatus(c *gin.Context, id string) {
+	var req api.MemberStatusUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	to := string(req.Status)
+	if !service.ValidStatus(to) || to == service.StatusPending {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be active, alumni or suspended"})
+		return
+	}
+
+	// 承認待ちは approve / reject でだけ動かす
+	from := []string{service.StatusActive, service.StatusAlumni, service.StatusSuspended}
+	err := h.membersSvc.UpdateStatus(c.Request.Context(), id, from, to)
+	h.respondStatusChange(c, "member status changed", id, err, "status", to)
+}
+
+// GetApiAdminMembersDeleted は削除済みのメンバーを削除が新しい順に返す（役員のみ）。
+func (h *Handler) GetApiAdminMembersDeleted(c *gin.Context) {
+	members, err := h.membersSvc.ListDeleted(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	out := make([]api.MemberDetail, 0, len(members))
+	for _, m := range members {
+		out = append(out, m.ToDetail())
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// DeleteApiAdminMembersId はメンバーを削除済みにする（役員のみ）。保持期間のうちは restore で戻せる。
+func (h *Handler) DeleteApiAdminMembersId(c *gin.Context, id string) {
+	err := h.membersSvc.Delete(c.Request.Context(), id)
+	h.respondStatusChange(c, "member deleted", id, err)
+}
+
+// PostApiAdminMembersIdRestore は削除済みのメンバーを元に戻す（役員のみ）。
+func (h *Handler) PostApiAdminMembersIdRestore(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	m, err := h.membersSvc.Restore(ctx, id)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	case errors.Is(err, service.ErrMemberNotDeleted), errors.Is(err, service.ErrStudentIDTaken), errors.Is(err, service.ErrLineUserIDTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	default:
+		logging.FromContext(ctx).Error("failed to restore member", "member", id, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logging.FromContext(ctx).Info("member restored", "member", id, "by", currentMemberID(c))
+	h.searchIndex.Invalidate()
+	c.JSON(http.StatusOK, m.ToDetail())
+}
+
+// respondStatusChange は在籍状況の変更結果をレスポンスに変換し、誰が変えたかをログに残す。
+func (h *Handler) respondStatusChange(c *gin.Context, msg, id string, err error, attrs ...any) {
+	ctx := c.Request.Context()
+	switch {
+	case err == nil:
+		logging.FromContext(ctx).Info(msg, append([]any{"member", id, "by", currentMemberID(c)}, attrs...)...)
+		h.searchIndex.Invalidate()
+		c.Status(http.StatusNoContent)
+	case errors.Is(err, service.ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+	case errors.Is(err, service.ErrStatusConflict), errors.Is(err, service.ErrMemberDeleted), errors.Is(err, service.ErrAlreadyMerged):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		logging.FromContext(ctx).Error("failed to change member status", "member", id, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
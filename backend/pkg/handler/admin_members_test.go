@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// newMemberRequest は（member が空でなければ）そのメンバーのセッション付きリクエストを作る。
+func newMemberRequest(t *testing.T, h *Handler, method, path, body, member string) *http.Request {
+	t.Helper()
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, path, nil)
+	} else {
+		req = httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if member != "" {
+		req = withCookies(t, req, func(c *gin.Context) error { return h.issueSession(c, member) })
+	}
+	return req
+}
+
+func TestGetApiMembers_HidesInactiveFromRegularUsers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Status: service.StatusPending}
+	members.members["m-alumni"] = &service.Member{Id: "m-alumni", Status: service.StatusAlumni}
+	router := newLinkRouter(h)
+
+	list := func(member string) map[string]bool {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members", "", member))
+		var got []api.MemberSummary
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		ids := map[string]bool{}
+		for _, m := range got {
+			ids[m.Id] = true
+		}
+		return ids
+	}
+
+	// status のない既存メンバー（m-officer / m-regular）は active 扱い
+	if ids := list(""); len(ids) != 2 || ids["m-pending"] || ids["m-alumni"] {
+		t.Fatalf("anonymous users should only see active members: %v", ids)
+	}
+	if ids := list("m-regular"); len(ids) != 2 {
+		t.Fatalf("regular members should only see active members: %v", ids)
+	}
+	if ids := list("m-officer"); len(ids) != 4 {
+		t.Fatalf("officers should see every member: %v", ids)
+	}
+
+	// 詳細も本人と役員以外には 404
+	for member, want := range map[string]int{"": http.StatusNotFound, "m-regular": http.StatusNotFound, "m-pending": http.StatusOK, "m-officer": http.StatusOK} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members/m-pending", "", member))
+		if w.Code != want {
+			t.Fatalf("detail as %q: expected %d, got %d", member, want, w.Code)
+		}
+	}
+}
+
+func TestGetApiMembers_StatusFilter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Status: service.StatusPending}
+	members.members["m-alumni"] = &service.Member{Id: "m-alumni", Status: service.StatusAlumni}
+	router := newLinkRouter(h)
+
+	// 卒業生の一覧と詳細は誰でも見られる
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members?status=alumni", "", ""))
+	var got []api.MemberSummary
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Id != "m-alumni" {
+		t.Fatalf("expected only m-alumni, got %+v", got)
+	}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members/m-alumni", "", ""))
+	if w.Code != http.StatusOK {
+		t.Fatalf("alumni detail: expected 200, got %d", w.Code)
+	}
+
+	// 承認待ちでの絞り込みは役員だけ
+	for member, want := range map[string]int{"m-regular": http.StatusForbidden, "m-officer": http.StatusOK} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members?status=pending", "", member))
+		if w.Code != want {
+			t.Fatalf("pending filter as %q: expected %d, got %d", member, want, w.Code)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members?status=graduated", "", "m-officer"))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unknown status: expected 400, got %d", w.Code)
+	}
+}
+
+func TestAdminMembers_ApprovalQueue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	now := time.Now()
+	members.members["m-new"] = &service.Member{Id: "m-new", Status: service.StatusPending, CreatedAt: now}
+	members.members["m-old"] = &service.Member{Id: "m-old", Status: service.StatusPending, CreatedAt: now.Add(-time.Hour)}
+	router := newLinkRouter(h)
+
+	serve := func(method, path, body, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, body, member))
+		return w
+	}
+
+	if w := serve(http.MethodGet, "/api/admin/members/pending", "", "m-regular"); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for regular member, got %d", w.Code)
+	}
+
+	// 登録が古い順に並ぶ
+	w := serve(http.MethodGet, "/api/admin/members/pending", "", "m-officer")
+	var queue []api.MemberDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &queue); err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 2 || queue[0].Id != "m-old" || queue[1].Id != "m-new" {
+		t.Fatalf("unexpected queue: %+v", queue)
+	}
+
+	if w := serve(http.MethodPost, "/api/admin/members/m-old/approve", "", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("approve: expected 204, got %d", w.Code)
+	}
+	if members.members["m-old"].Status != service.StatusActive {
+		t.Fatalf("member was not approved: %+v", members.members["m-old"])
+	}
+	// 承認済みのメンバーは承認も却下もできない
+	if w := serve(http.MethodPost, "/api/admin/members/m-old/reject", "", "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("reject active: expected 409, got %d", w.Code)
+	}
+
+	if w := serve(http.MethodPost, "/api/admin/members/m-new/reject", "", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("reject: expected 204, got %d", w.Code)
+	}
+	if _, ok := members.members["m-new"]; ok {
+		t.Fatal("rejected member was not deleted")
+	}
+}
+
+func TestPutApiAdminMembersIdStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Status: service.StatusPending}
+	router := newLinkRouter(h)
+
+	for _, tc := range []struct {
+		id, body string
+		want     int
+	}{
+		{id: "m-regular", body: `{"status":"suspended"}`, want: http.StatusNoContent},
+		{id: "m-regular", body: `{"status":"pending"}`, want: http.StatusBadRequest},
+		{id: "m-regular", body: `{"status":"retired"}`, want: http.StatusBadRequest},
+		{id: "m-pending", body: `{"status":"active"}`, want: http.StatusConflict},
+		{id: "m-missing", body: `{"status":"alumni"}`, want: http.StatusNotFound},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPut, "/api/admin/members/"+tc.id+"/status", tc.body, "m-officer"))
+		if w.Code != tc.want {
+			t.Fatalf("%s %s: expected %d, got %d", tc.id, tc.body, tc.want, w.Code)
+		}
+	}
+	if members.members["m-regular"].Status != service.StatusSuspended {
+		t.Fatalf("status was not changed: %+v", members.members["m-regular"])
+	}
+
+	// 利用停止中のメンバーはログインできない
+	h.providers["example"] = &fakeProvider{user: identity.UserInfo{Subject: "g-2"}}
+	h.identitiesSvc.Link(t.Context(), "example", "g-2", "m-regular", "")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newAuthCallbackRequest(t, h, "example", "good", ""))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("suspended login: expected 403, got %d", w.Code)
+	}
+}
+
+func TestAdminMembers_SoftDelete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	do := func(method, path, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, "", member))
+		return w
+	}
+
+	if w := do(http.MethodDelete, "/api/admin/members/m-regular", "m-regular"); w.Code != http.StatusForbidden {
+		t.Fatalf("regular member: expected 403, got %d", w.Code)
+	}
+	if w := do(http.MethodDelete, "/api/admin/members/m-none", "m-officer"); w.Code != http.StatusNotFound {
+		t.Fatalf("unknown member: expected 404, got %d", w.Code)
+	}
+	if w := do(http.MethodDelete, "/api/admin/members/m-regular", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("delete: expected 204, got %d: %s", w.Code, w.Body.String())
+	}
+	if !members.members["m-regular"].Deleted() {
+		t.Fatal("member should be marked as deleted")
+	}
+	if w := do(http.MethodDelete, "/api/admin/members/m-regular", "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("delete twice: expected 409, got %d", w.Code)
+	}
+
+	// 一覧からは消え、詳細は役員以外には 404
+	w := do(http.MethodGet, "/api/members", "m-officer")
+	var list []api.MemberSummary
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Id != "m-officer" {
+		t.Fatalf("deleted member should not be listed: %+v", list)
+	}
+	for member, want := range map[string]int{"": http.StatusNotFound, "m-officer": http.StatusOK} {
+		if w := do(http.MethodGet, "/api/members/m-regular", member); w.Code != want {
+			t.Fatalf("detail as %q: expected %d, got %d", member, want, w.Code)
+		}
+	}
+
+	// 削除されたメンバーのセッションは使えない
+	if w := do(http.MethodGet, "/api/me/social", "m-regular"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("deleted member session: expected 401, got %d", w.Code)
+	}
+
+	// 役員は削除済みの一覧を見て元に戻せる
+	w = do(http.MethodGet, "/api/admin/members/deleted", "m-officer")
+	var deleted []api.MemberDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &deleted); err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || deleted[0].Id != "m-regular" || deleted[0].DeletedAt == nil {
+		t.Fatalf("unexpected deleted members: %+v", deleted)
+	}
+	w = do(http.MethodPost, "/api/admin/members/m-regular/restore", "m-officer")
+	if w.Code != http.StatusOK {
+		t.Fatalf("restore: expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if members.members["m-regular"].Deleted() {
+		t.Fatal("member should be restored")
+	}
+	if w := do(http.MethodPost, "/api/admin/members/m-regular/restore", "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("restore twice: expected 409, got %d", w.Code)
+	}
+	if w := do(http.MethodGet, "/api/members/m-regular", ""); w.Code != http.StatusOK {
+		t.Fatalf("restored detail: expected 200, got %d", w.Code)
+	}
+
+	// 削除している間に同じ学籍番号で登録し直されていたら戻せない
+	members.members["m-regular"].StudentID = "24T0001"
+	members.members["m-new"] = &service.Member{Id: "m-new", StudentID: "24T0001"}
+	if w := do(http.MethodDelete, "/api/admin/members/m-regular", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("delete: expected 204, got %d", w.Code)
+	}
+	if w := do(http.MethodPost, "/api/admin/members/m-regular/restore", "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("restore with a taken student id: expected 409, got %d", w.Code)
+	}
+}
+
+func TestRequireAuth_MemberStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range []struct {
+		status        string
+		self, officer int
+	}{
+		{status: service.StatusActive, self: http.StatusOK, officer: http.StatusOK},
+		// 卒業生は自分のページは使えるが、役職が残っていても役員の操作はできない
+		{status: service.StatusAlumni, self: http.StatusOK, officer: http.StatusForbidden},
+		{status: service.StatusPending, self: http.StatusForbidden, officer: http.StatusForbidden},
+		{status: service.StatusSuspended, self: http.StatusForbidden, officer: http.StatusForbidden},
+	} {
+		h, members, _ := newInvitationTestHandler(t)
+		members.members["m-officer"].Status = tc.status
+		router := newLinkRouter(h)
+
+		for path, want := range map[string]int{"/api/me/social": tc.self, "/api/admin/members/pending": tc.officer} {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, path, "", "m-officer"))
+			if w.Code != want {
+				t.Fatalf("%s %s: expected %d, got %d", tc.status, path, want, w.Code)
+			}
+			// 承認待ち・利用停止のセッションは Cookie ごと消す
+			cleared := false
+			for _, ck := range w.Result().Cookies() {
+				cleared = cleared || (ck.Name == AuthCookieName && ck.MaxAge < 0)
+			}
+			if wantCleared := tc.status == service.StatusPending || tc.status == service.StatusSuspended; cleared != wantCleared {
+				t.Fatalf("%s %s: cookie cleared = %v", tc.status, path, cleared)
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/jwt"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// AuthCookieName はログインセッション（JWT）を保持する Cookie 名。
+	AuthCookieName = "auth_token"
+	// sessionIssuer はセッション JWT の iss。
+	sessionIssuer = "profile-system"
+	// userIDKey は認証済みメンバーの ID を gin.Context に保持するキー。
+	userIDKey = "user_id"
+
+	// onboardingCookie は外部 ID でログインしたがメンバー未登録のユーザーに渡す、登録用の一時トークン。
+	onboardingCookie = "onboarding_token"
+	// onboardingIssuer は登録用トークンの iss（セッションとして使われないよう区別する）。
+	onboardingIssuer = "profile-system/onboarding"
+	onboardingTTL    = 30 * time.Minute
+
+	// officerScope は役員のみが使えるエンドポイントに OpenAPI で付けるスコープ。
+	officerScope = "officer"
+)
+
+// issueSession はメンバーのログインセッションを発行して Cookie にセットする。
+func (h *Handler) issueSession(c *gin.Context, memberID string) error {
+	claims := jwt.CreateSessionClaims(memberID, sessionIssuer, time.Now(), h.sessionTTL)
+	token, err := h.sessions.IssueJWT(claims)
+	if err != nil {
+		return err
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(AuthCookieName, token, int(h.sessionTTL.Seconds()), "/", "", false, true)
+	c.Set(userIDKey, memberID)
+	return nil
+}
+
+// Session は auth_token Cookie があれば検証し、メンバー ID を "user_id" にセットする。
+// 未ログインでもそのまま通す（認証が必須かどうかは RequireAuth が判定する）。
+// ルーター全体に掛けるので、レート制限やアクセスログでもユーザー単位で扱える。
+func (h *Handler) Session(c *gin.Context) {
+	token, err := c.Cookie(AuthCookieName)
+	if err != nil || token == "" {
+		c.Next()
+		return
+	}
+	claims, err := h.sessions.AuthenticateJWT(token)
+	if err == nil && claims.Issuer == sessionIssuer && claims.UserID != "" {
+		c.Set(userIDKey, claims.UserID)
+	}
+	c.Next()
+}
+
+// RequireAuth は OpenAPI で cookieAuth が指定されたエンドポイントについて、ログイン済みかを確認する。
+// セッションのメンバーが退会（DELETE /api/me）や削除でいなくなっていれば、そのセッションは無効として Cookie を消す。
+// 別のメンバーに統合されたメンバーのセッションも同じく無効にする（統合先のアカウントでログインし直してもらう）。
+// ログイン後に利用停止になったメンバーと、承認待ちのメンバーのセッションも Cookie を消して断る。
+// スコープに officer があれば役員かどうかも確認する。
+// api.RegisterHandlersWithOptions の Middlewares に渡す（生成コードが CookieAuthScopes をセットした後に呼ばれる）。
+func (h *Handler) RequireAuth(c *gin.Context) {
+	scopes, secured := c.Get(api.CookieAuthScopes)
+	if !secured {
+		return
+	}
+	memberID := currentMemberID(c)
+	if memberID == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "login required"})
+		return
+	}
+	m, err := h.membersSvc.Get(c.Request.Context(), memberID)
+	if errors.Is(err, service.ErrMemberNotFound) || (err == nil && (m.Deleted() || m.MergedInto != "")) {
+		c.SetCookie(AuthCookieName, "", -1, "/", "", false, true)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "session is no longer valid"})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	switch m.EffectiveStatus() {
+	case service.StatusSuspended:
+		c.SetCookie(AuthCookieName, "", -1, "/", "", false, true)
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "this account is suspended"})
+		return
+	case service.StatusPending:
+		c.SetCookie(AuthCookieName, "", -1, "/", "", false, true)
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "this account is awaiting approval"})
+		return
+	}
+	if s, _ := scopes.([]string); slices.Contains(s, officerScope) && !h.officer(m) {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "officers only"})
+		return
+	}
+}
+
+// isOfficer はメンバーが役員かを返す（officer の判定は officer を参照）。
+func (h *Handler) isOfficer(ctx context.Context, memberID string) (bool, error) {
+	m, err := h.membersSvc.Get(ctx, memberID)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return h.officer(m), nil
+}
+
+// officer は在籍中（削除済みでない）で auth.officer_roles のいずれかの役職を持つメンバーを役員とみなす。
+// 卒業生・承認待ち・利用停止のメンバーは役職が残っていても役員として扱わない。
+func (h *Handler) officer(m *service.Member) bool {
+	if m.Deleted() || m.EffectiveStatus() != service.StatusActive {
+		return false
+	}
+	return slices.ContainsFunc(m.Roles, func(r string) bool { return slices.Contains(h.officerRoles, r) })
+}
+
+// viewerIsOfficer はリクエストしたメンバーが役員かを返す。未ログインなら false。
+func (h *Handler) viewerIsOfficer(c *gin.Context) (bool, error) {
+	memberID := currentMemberID(c)
+	if memberID == "" {
+		return false, nil
+	}
+	return h.isOfficer(c.Request.Context(), memberID)
+}
+
+// issueOnboarding は外部 ID でログインした未登録ユーザーに、招待コードで登録するための一時トークンを渡す。
+func (h *Handler) issueOnboarding(c *gin.Context, provider, subject string) error {
+	claims := jwt.CreateSessionClaims(provider+":"+subject, onboardingIssuer, time.Now(), onboardingTTL)
+	token, err := h.sessions.IssueJWT(claims)
+	if err != nil {
+		return err
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(onboardingCookie, token, int(onboardingTTL.Seconds()), "/api", "", false, true)
+	return nil
+}
+
+// onboardingIdentity は登録用トークンから (provider, subject) を取り出す。なければ ok=false。
+func (h *Handler) onboardingIdentity(c *gin.Context) (provider, subject string, ok bool) {
+	token, err := c.Cookie(onboardingCookie)
+	if err != nil || token == "" {
+		return "", "", false
+	}
+	claims, err := h.sessions.AuthenticateJWT(token)
+	if err != nil || claims.Issuer != onboardingIssuer {
+		return "", "", false
+	}
+	return strings.Cut(claims.UserID, ":")
+}
+
+// currentMemberID はログイン中のメンバー ID を返す。未ログインなら空文字。
+func currentMemberID(c *gin.Context) string {
+	return c.GetString(userIDKey)
+}
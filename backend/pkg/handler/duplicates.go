@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/search"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultDuplicateScore は重複候補として返す同じ人らしさの下限の既定値
+const defaultDuplicateScore = 0.8
+
+// GetApiAdminMembersDuplicates は同じ人が二重に登録されていそうなメンバーの組を返す（役員のみ）。
+func (h *Handler) GetApiAdminMembersDuplicates(c *gin.Context, params api.GetApiAdminMembersDuplicatesParams) {
+	minScore := defaultDuplicateScore
+	if params.MinScore != nil {
+		minScore = float64(*params.MinScore)
+	}
+	if minScore < 0 || minScore > 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "min_score must be between 0 and 1"})
+		return
+	}
+
+	members, err := h.membersSvc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	dups := search.FindDuplicates(members, minScore)
+	out := make([]api.DuplicateCandidate, 0, len(dups))
+	for _, d := range dups {
+		reasons := make([]api.DuplicateCandidateReasons, 0, len(d.Reasons))
+		for _, r := range d.Reasons {
+			reasons = append(reasons, api.DuplicateCandidateReasons(r))
+		}
+		out = append(out, api.DuplicateCandidate{
+			Members: []api.MemberSummary{d.Members[0].ToSummary(), d.Members[1].ToSummary()},
+			Score:   float32(d.Score),
+			Reasons: reasons,
+		})
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// PostApiAdminMembersIdMerge は source_id のメンバーを id のメンバーに統合する（役員のみ）。
+func (h *Handler) PostApiAdminMembersIdMerge(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	var req api.MemberMerge
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.SourceId == "" || req.SourceId == id {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "source_id must be another member"})
+		return
+	}
+
+	merged, err := h.membersSvc.Merge(ctx, id, req.SourceId)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	case errors.Is(err, service.ErrAlreadyMerged), errors.Is(err, service.ErrMemberDeleted):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	default:
+		logging.FromContext(ctx).Error("failed to merge members", "member", id, "source", req.SourceId, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logging.FromContext(ctx).Info("members merged", "member", id, "source", req.SourceId, "by", currentMemberID(c))
+	h.searchIndex.Invalidate()
+	c.JSON(http.StatusOK, merged.ToDetail())
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetApiAdminMembersDuplicates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-a"] = &service.Member{Id: "m-a", Name: "田中 太郎", StudentID: "24T0001"}
+	members.members["m-b"] = &service.Member{Id: "m-b", Name: "別の名前", StudentID: "24T0001"}
+	router := newLinkRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/admin/members/duplicates", "", "m-regular"))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("regular member: expected 403, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/admin/members/duplicates", "", "m-officer"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var got []api.DuplicateCandidate
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Score != 1 || len(got[0].Reasons) != 1 || got[0].Reasons[0] != api.DuplicateCandidateReasonsStudentId {
+		t.Fatalf("unexpected candidates: %+v", got)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/admin/members/duplicates?min_score=2", "", "m-officer"))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("min_score out of range: expected 400, got %d", w.Code)
+	}
+}
+
+func TestPostApiAdminMembersIdMerge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-a"] = &service.Member{Id: "m-a", Name: "田中 太郎", Tags: []string{"go"}}
+	members.members["m-b"] = &service.Member{Id: "m-b", Name: "たなか", Department: "情報工学科", Tags: []string{"rust"}}
+	router := newLinkRouter(h)
+
+	merge := func(target, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/admin/members/"+target+"/merge", body, "m-officer"))
+		return w
+	}
+
+	if w := merge("m-a", `{"source_id":"m-a"}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("merge into itself: expected 400, got %d", w.Code)
+	}
+	if w := merge("m-a", `{"source_id":"m-none"}`); w.Code != http.StatusNotFound {
+		t.Fatalf("unknown source: expected 404, got %d", w.Code)
+	}
+	// 削除済みのメンバーは統合できない（復元してから統合する）
+	members.members["m-deleted"] = &service.Member{Id: "m-deleted", Name: "田中", DeletedAt: time.Now()}
+	if w := merge("m-a", `{"source_id":"m-deleted"}`); w.Code != http.StatusConflict {
+		t.Fatalf("deleted source: expected 409, got %d", w.Code)
+	}
+	if w := merge("m-deleted", `{"source_id":"m-b"}`); w.Code != http.StatusConflict {
+		t.Fatalf("deleted target: expected 409, got %d", w.Code)
+	}
+
+	w := merge("m-a", `{"source_id":"m-b"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var got api.MemberDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Id != "m-a" || got.Name != "田中 太郎" || got.Department != "情報工学科" {
+		t.Fatalf("unexpected merged member: %+v", got)
+	}
+	if members.members["m-b"].MergedInto != "m-a" {
+		t.Fatalf("source should point to the target: %+v", members.members["m-b"])
+	}
+
+	// 統合済みのメンバーはもう統合できず、詳細は統合先へリダイレクトする
+	if w := merge("m-a", `{"source_id":"m-b"}`); w.Code != http.StatusConflict {
+		t.Fatalf("already merged: expected 409, got %d", w.Code)
+	}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members/m-b", "", ""))
+	if w.Code != http.StatusMovedPermanently || w.Header().Get("Location") != "/api/members/m-a" {
+		t.Fatalf("expected redirect to m-a, got %d %q", w.Code, w.Header().Get("Location"))
+	}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members", "", ""))
+	var list []api.MemberSummary
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range list {
+		if m.Id == "m-b" {
+			t.Fatal("merged member should not be listed")
+		}
+	}
+
+	// 統合されたメンバーのセッションは無効になり、Cookie も消える
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/me/social", "", "m-b"))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("merged member session: expected 401, got %d", w.Code)
+	}
+	cleared := false
+	for _, ck := range w.Result().Cookies() {
+		cleared = cleared || (ck.Name == AuthCookieName && ck.MaxAge < 0)
+	}
+	if !cleared {
+		t.Fatal("merged member session cookie should be cleared")
+	}
+}
+
+func TestPostApiMembers_StudentIDTaken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-a"] = &service.Member{Id: "m-a", Name: "田中 太郎", StudentID: "24T0001"}
+	router := newLinkRouter(h)
+
+	for body, want := range map[string]int{
+		`{"name":"たなか","student_id":"24t0001"}`: http.StatusConflict,
+		`{"name":"たなか","student_id":"!!"}`:      http.StatusBadRequest,
+		`{"name":"たなか","student_id":"24T0002"}`: http.StatusCreated,
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members", body, "m-officer"))
+		if w.Code != want {
+			t.Fatalf("%s: expected %d, got %d: %s", body, want, w.Code, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/export"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetApiMembersExport はメンバー名簿を CSV・XLSX・JSON で書き出す（役員のみ）。
+// 学籍番号などの個人情報の列は include_sensitive=true を明示したときだけ書き出す。
+func (h *Handler) GetApiMembersExport(c *gin.Context, params api.GetApiMembersExportParams) {
+	ctx := c.Request.Context()
+
+	// --- ① 形式と列を確認 ---
+	format := export.FormatCSV
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+	if format != export.FormatCSV && format != export.FormatXLSX && format != export.FormatJSON {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv, xlsx or json"})
+		return
+	}
+	var keys []string
+	if params.Columns != nil {
+		for _, k := range *params.Columns {
+			keys = append(keys, string(k))
+		}
+	}
+	sensitive := params.IncludeSensitive != nil && *params.IncludeSensitive
+	cols, err := export.SelectColumns(keys, sensitive)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// --- ② 絞り込み条件を確認 ---
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+		if !service.ValidStatus(status) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, active, alumni or suspended"})
+			return
+		}
+	}
+	var roleID string
+	if params.Role != nil {
+		teams, ok := h.resolveTeams(c, []string{*params.Role})
+		if !ok {
+			return
+		}
+		roleID = teams[0].Id
+	}
+	var tags []string
+	if params.Tag != nil {
+		for _, t := range *params.Tag {
+			tags = append(tags, service.NormalizeTag(t))
+		}
+	}
+
+	// --- ③ 対象のメンバーを名前順にそろえる ---
+	members, err := h.membersSvc.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	out := make([]service.Member, 0, len(members))
+	for _, m := range members {
+		switch {
+		case status != "" && m.EffectiveStatus() != status:
+			continue
+		case roleID != "" && !slices.Contains(m.RoleIDs, roleID):
+			continue
+		case params.EnrollmentYear != nil && m.EnrollmentYear != *params.EnrollmentYear:
+			continue
+		case !m.HasTags(tags):
+			continue
+		}
+		out = append(out, m)
+	}
+	slices.SortStableFunc(out, func(a, b service.Member) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Id, b.Id))
+	})
+
+	// --- ④ 書き出す ---
+	// 個人情報を含む書き出しは後から追えるように残す
+	now := time.Now()
+	logging.FromContext(ctx).Info("members exported", "format", format, "count", len(out), "include_sensitive", sensitive, "by", currentMemberID(c))
+	c.Header("Content-Type", export.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="members-%s.%s"`, now.Format("20060102"), format))
+	c.Status(http.StatusOK)
+	if err := export.Write(c.Writer, format, cols, out, now); err != nil {
+		// ヘッダーは送信済みなので、ステータスは変えられない
+		logging.FromContext(ctx).Error("failed to write member export", "error", err)
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetApiMembersExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-officer"].Name, members.members["m-officer"].StudentID = "鈴木", "22T0001"
+	members.members["m-regular"].Name, members.members["m-regular"].StudentID = "田中", "24T0001"
+	members.members["m-alumni"] = &service.Member{Id: "m-alumni", Name: "佐藤", Status: service.StatusAlumni, RoleIDs: []string{"web"}, Roles: []string{"Web班"}}
+	router := newLinkRouter(h)
+
+	get := func(path, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, path, "", member))
+		return w
+	}
+
+	if w := get("/api/members/export", "m-regular"); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for non-officers, got %d", w.Code)
+	}
+
+	// 既定は CSV で、名前順・個人情報の列なし
+	w := get("/api/members/export?columns=id,name", "m-officer")
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv") {
+		t.Fatalf("unexpected response %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(w.Header().Get("Content-Disposition"), ".csv") {
+		t.Fatalf("unexpected disposition: %q", w.Header().Get("Content-Disposition"))
+	}
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(w.Body.String(), "\ufeff"))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 || records[1][1] != "佐藤" || records[2][1] != "田中" || records[3][1] != "鈴木" {
+		t.Fatalf("unexpected csv: %v", records)
+	}
+
+	// 学籍番号は include_sensitive を明示したときだけ
+	if w := get("/api/members/export?columns=name,student_id", "m-officer"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without include_sensitive, got %d", w.Code)
+	}
+	w = get("/api/members/export?format=json&columns=name,student_id&include_sensitive=true&role=web班&status=active", "m-officer")
+	var rows []map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &rows); err != nil {
+		t.Fatalf("invalid json (%d): %s", w.Code, w.Body.String())
+	}
+	if len(rows) != 1 || rows[0]["name"] != "田中" || rows[0]["student_id"] != "24T0001" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+
+	if w := get("/api/members/export?format=pdf", "m-officer"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown format, got %d", w.Code)
+	}
+	if w := get("/api/members/export?role=存在しない班", "m-officer"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown role, got %d", w.Code)
+	}
+	if w := get("/api/members/export?format=xlsx", "m-officer"); w.Code != http.StatusOK || !strings.HasPrefix(w.Body.String(), "PK") {
+		t.Fatalf("expected an xlsx file, got %d", w.Code)
+	}
+}
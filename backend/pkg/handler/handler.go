@@ -1,19 +1,26 @@
 package handler
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log/slog"
 	"net/http"
-	"net/url"
-	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/Lumos-Programming/profile-system-backend/api"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/discord"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/github"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/jwt"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/line"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/search"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,135 +30,125 @@ const (
 	firestoreDocID      = "default" // 単一プロフィールの場合
 )
 
-type Handler struct {
-	fs         *firestore.Client
-	httpClient *http.Client
-	lineCfg    config.LINE
-	membersSvc *service.MembersService
-}
+const (
+	// ログイン開始からコールバックまでの猶予（秒）
+	lineLoginCookieMaxAge = 600
+)
 
-func NewHandler(f *firestore.Client, lineCfg config.LINE, membersSvc *service.MembersService) *Handler {
-	return &Handler{
-		fs: f,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-		lineCfg:    lineCfg,
-		membersSvc: membersSvc,
-	}
+// membersService は Handler が使うメンバー操作。本番では *service.MembersService、テストではフェイクを使う。
+type membersService interface {
+	List(ctx context.Context) ([]service.Member, error)
+	Get(ctx context.Context, id string) (*service.Member, error)
+	Register(ctx context.Context, m service.Member) (string, error)
+	Import(ctx context.Context, members []service.Member) ([]string, []error)
+	RecordLineLogin(ctx context.Context, id, email string) error
+	LinkAccount(ctx context.Context, id, provider string, acc service.LinkedAccount) error
+	UnlinkAccount(ctx context.Context, id, provider string) error
+	UpdateStatus(ctx context.Context, id string, from []string, to string) error
+	DeletePending(ctx context.Context, id string) error
+	ListByRole(ctx context.Context, teamID string) ([]service.Member, error)
+	UpdateTags(ctx context.Context, id string, tags []string) error
+	UpdateSocial(ctx context.Context, id string, handles service.SocialHandles, vis service.Visibility) error
+	Merge(ctx context.Context, targetID, sourceID string) (*service.Member, error)
+	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (*service.Member, error)
+	ListDeleted(ctx context.Context) ([]service.Member, error)
+	PersonalData(ctx context.Context, id string) (*service.PersonalData, error)
+	Erase(ctx context.Context, id string) error
 }
 
-type lineTokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	ExpiresIn    int    `json:"expires_in"`
-	RefreshToken string `json:"refresh_token"`
+type Handler struct {
+	fs      *firestore.Client
+	discord *identity.LinkClient
+	github  *identity.LinkClient
+	// providers はログインに使える ID プロバイダ（キーは /api/auth/{provider} の provider）
+	providers      map[string]identity.Provider
+	sessions       *jwt.Manager
+	sessionTTL     time.Duration
+	officerRoles   []string
+	membersSvc     membersService
+	identitiesSvc  identitiesService
+	invitationsSvc invitationsService
+	teamsSvc       teamsService
+	termsSvc       termsService
+	// searchIndex はメンバー検索用の索引。searchIndexTTL ごと、またはメンバーの変更後に作り直す
+	searchIndex    *search.Index
+	searchIndexTTL time.Duration
 }
 
-type lineProfileResponse struct {
-	UserID        string `json:"userId"`
-	DisplayName   string `json:"displayName"`
-	PictureURL    string `json:"pictureUrl"`
-	StatusMessage string `json:"statusMessage"`
+// Services は Handler が使う Service 層の実装。
+type Services struct {
+	Members     *service.MembersService
+	Identities  *service.IdentitiesService
+	Invitations *service.InvitationsService
+	Teams       *service.TeamsService
+	Terms       *service.TermsService
 }
 
-func (h *Handler) GetApiLineOauth(c *gin.Context, params api.GetApiLineOauthParams) {
-	channelID := h.lineCfg.ChannelID
-	channelSecret := h.lineCfg.ChannelSecret
-	redirectURI := h.lineCfg.RedirectURI
-
-	if channelID == "" || channelSecret == "" || redirectURI == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "LINE OAuth settings are not configured"})
-		return
+func NewHandler(f *firestore.Client, cfg *config.Config, svc Services) *Handler {
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+		// LINE / Discord / GitHub API 呼び出しもトレースに含める
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
 	}
-
-	tokenForm := url.Values{}
-	tokenForm.Set("grant_type", "authorization_code")
-	tokenForm.Set("code", params.Code)
-	tokenForm.Set("redirect_uri", redirectURI)
-	tokenForm.Set("client_id", channelID)
-	tokenForm.Set("client_secret", channelSecret)
-
-	tokenReq, err := http.NewRequestWithContext(c.Request.Context(), http.MethodPost, "https://api.line.me/oauth2/v2.1/token", strings.NewReader(tokenForm.Encode()))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	tokenRes, err := h.httpClient.Do(tokenReq)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	defer tokenRes.Body.Close()
-
-	tokenBody, err := io.ReadAll(tokenRes.Body)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if tokenRes.StatusCode != http.StatusOK {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("line token exchange failed: %s", string(tokenBody))})
-		return
-	}
-
-	var token lineTokenResponse
-	if err := json.Unmarshal(tokenBody, &token); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	return &Handler{
+		fs:             f,
+		discord:        discord.NewClient(cfg.Discord, httpClient),
+		github:         github.NewClient(cfg.GitHub, httpClient),
+		providers:      newIdentityProviders(cfg, httpClient),
+		sessions:       jwt.NewManager([]byte(cfg.Auth.JWTSecret)),
+		sessionTTL:     cfg.Auth.SessionTTL,
+		officerRoles:   cfg.Auth.OfficerRoles,
+		membersSvc:     svc.Members,
+		identitiesSvc:  svc.Identities,
+		invitationsSvc: svc.Invitations,
+		teamsSvc:       svc.Teams,
+		termsSvc:       svc.Terms,
+		searchIndex:    search.NewIndex(),
+		searchIndexTTL: cfg.SearchIndexTTL,
 	}
+}
 
-	profileReq, err := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, "https://api.line.me/v2/profile", nil)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+// newIdentityProviders は LINE と、設定ファイルの identity_providers に書かれたプロバイダを用意する。
+func newIdentityProviders(cfg *config.Config, httpClient *http.Client) map[string]identity.Provider {
+	// LINE のコールバック先は /api/auth/line/callback。未設定なら従来の /api/line-oauth（同じ処理）を使う
+	lineCfg := cfg.LINE
+	if cfg.LINE.AuthRedirectURI != "" {
+		lineCfg.RedirectURI = cfg.LINE.AuthRedirectURI
 	}
-	profileReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
-
-	profileRes, err := h.httpClient.Do(profileReq)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	providers := map[string]identity.Provider{
+		identity.ProviderLINE: identity.NewLINE(line.NewClient(lineCfg, httpClient)),
 	}
-	defer profileRes.Body.Close()
-
-	profileBody, err := io.ReadAll(profileRes.Body)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	for name, pc := range cfg.IdentityProviders {
+		if _, ok := providers[name]; ok {
+			slog.Warn("identity provider name is reserved; ignoring", "provider", name)
+			continue
+		}
+		providers[name] = identity.NewOAuth2(pc, httpClient)
 	}
+	return providers
+}
 
-	if profileRes.StatusCode != http.StatusOK {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("line profile fetch failed: %s", string(profileBody))})
-		return
-	}
+// GetApiLineLogin は /api/auth/line/start と同じく LINE の認可画面へリダイレクトする（旧クライアント向け）。
+func (h *Handler) GetApiLineLogin(c *gin.Context) {
+	h.GetApiAuthProviderStart(c, identity.ProviderLINE)
+}
 
-	var profile lineProfileResponse
-	if err := json.Unmarshal(profileBody, &profile); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
+// GetApiLineOauth は /api/auth/line/callback と同じく LINE ユーザーに対応するメンバーとしてログインさせる（旧クライアント向け）。
+func (h *Handler) GetApiLineOauth(c *gin.Context, params api.GetApiLineOauthParams) {
+	h.GetApiAuthProviderCallback(c, identity.ProviderLINE, api.GetApiAuthProviderCallbackParams{
+		Code:  params.Code,
+		State: params.State,
+	})
+}
 
-	res := api.LineOAuthResponse{
-		AccessToken: token.AccessToken,
-		ExpiresIn:   token.ExpiresIn,
-		User: api.LineUser{
-			UserId:      profile.UserID,
-			DisplayName: profile.DisplayName,
-		},
-	}
-	if token.RefreshToken != "" {
-		res.RefreshToken = &token.RefreshToken
+// randomToken は state / nonce 用の推測できないランダム文字列を生成する。
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	if profile.PictureURL != "" {
-		res.User.PictureUrl = &profile.PictureURL
-	}
-	if profile.StatusMessage != "" {
-		res.User.StatusMessage = &profile.StatusMessage
-	}
-
-	c.JSON(http.StatusOK, res)
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
 func (h *Handler) GetApiProfileBasicInfo(c *gin.Context) {
@@ -161,9 +158,11 @@ func (h *Handler) GetApiProfileBasicInfo(c *gin.Context) {
 			c.JSON(200, api.BasicInfo{})
 			return
 		}
+		metrics.FirestoreError(firestoreCollection, "read")
 		c.JSON(500, gin.H{"error": err.Error()})
 		return
 	}
+	metrics.FirestoreRead(firestoreCollection, 1)
 	var info api.BasicInfo
 	if err := doc.DataTo(&info); err != nil {
 		c.JSON(500, gin.H{"error": err.Error()})
@@ -178,10 +177,16 @@ func (h *Handler) PutApiProfileBasicInfo(c *gin.Context) {
 		c.JSON(400, gin.H{"error": err.Error()})
 		return
 	}
+	if err := validateEnrollment(req.EnrollmentYear, req.ProgramYears, time.Now()); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
 	_, err := h.fs.Collection(firestoreCollection).Doc(firestoreDocID).Set(c, req)
 	if err != nil {
+		metrics.FirestoreError(firestoreCollection, "write")
 		c.JSON(500, gin.H{"error": err.Error()})
 		return
 	}
+	metrics.FirestoreWrite(firestoreCollection, 1)
 	c.JSON(200, req)
 }
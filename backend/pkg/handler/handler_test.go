@@ -1,95 +1,542 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Lumos-Programming/profile-system-backend/api"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/line"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 )
 
-type roundTripFunc func(*http.Request) (*http.Response, error)
+// fakeMembers は Firestore の代わりにメモリ上でメンバーを保持する。
+type fakeMembers struct {
+	members map[string]*service.Member
+}
+
+func newFakeMembers(ms ...service.Member) *fakeMembers {
+	f := &fakeMembers{members: make(map[string]*service.Member)}
+	for i := range ms {
+		f.members[ms[i].Id] = &ms[i]
+	}
+	return f
+}
+
+func (f *fakeMembers) List(ctx context.Context) ([]service.Member, error) {
+	out := make([]service.Member, 0, len(f.members))
+	for _, m := range f.members {
+		if m.MergedInto != "" || m.Deleted() {
+			continue
+		}
+		out = append(out, *m)
+	}
+	return out, nil
+}
+
+func (f *fakeMembers) Get(ctx context.Context, id string) (*service.Member, error) {
+	m, ok := f.members[id]
+	if !ok {
+		return nil, service.ErrMemberNotFound
+	}
+	return m, nil
+}
+
+func (f *fakeMembers) Register(ctx context.Context, m service.Member) (string, error) {
+	for _, o := range f.members {
+		if m.StudentID != "" && o.StudentID == m.StudentID && o.MergedInto == "" {
+			return "", service.ErrStudentIDTaken
+		}
+		if m.LineUserID != "" && o.LineUserID == m.LineUserID && o.MergedInto == "" {
+			return "", service.ErrLineUserIDTaken
+		}
+	}
+	m.Id = fmt.Sprintf("generated-%d", len(f.members))
+	f.members[m.Id] = &m
+	return m.Id, nil
+}
+
+func (f *fakeMembers) Import(ctx context.Context, members []service.Member) ([]string, []error) {
+	ids := make([]string, len(members))
+	errs := make([]error, len(members))
+	for i, m := range members {
+		for _, o := range f.members {
+			if m.StudentID != "" && o.StudentID == m.StudentID && o.MergedInto == "" {
+				errs[i] = fmt.Errorf("%w: %s", service.ErrStudentIDTaken, m.StudentID)
+			}
+		}
+		if errs[i] != nil {
+			continue
+		}
+		m.Id = fmt.Sprintf("imported-%d", len(f.members))
+		f.members[m.Id] = &m
+		ids[i] = m.Id
+	}
+	return ids, errs
+}
+
+func (f *fakeMembers) Merge(ctx context.Context, targetID, sourceID string) (*service.Member, error) {
+	target, ok := f.members[targetID]
+	source, ok2 := f.members[sourceID]
+	if !ok || !ok2 {
+		return nil, service.ErrMemberNotFound
+	}
+	if target.MergedInto != "" || source.MergedInto != "" {
+		return nil, service.ErrAlreadyMerged
+	}
+	if target.Deleted() || source.Deleted() {
+		return nil, service.ErrMemberDeleted
+	}
+	merged := service.MergeMembers(*target, *source)
+	f.members[targetID] = &merged
+	f.members[sourceID] = &service.Member{Id: sourceID, MergedInto: targetID, MergedAt: time.Now()}
+	return &merged, nil
+}
+
+func (f *fakeMembers) Delete(ctx context.Context, id string) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	if m.Deleted() {
+		return service.ErrMemberDeleted
+	}
+	m.DeletedAt = time.Now()
+	return nil
+}
+
+func (f *fakeMembers) Restore(ctx context.Context, id string) (*service.Member, error) {
+	m, ok := f.members[id]
+	if !ok {
+		return nil, service.ErrMemberNotFound
+	}
+	if !m.Deleted() {
+		return nil, service.ErrMemberNotDeleted
+	}
+	for _, o := range f.members {
+		if o.Id != id && m.StudentID != "" && o.StudentID == m.StudentID {
+			return nil, service.ErrStudentIDTaken
+		}
+	}
+	m.DeletedAt = time.Time{}
+	return m, nil
+}
+
+func (f *fakeMembers) ListDeleted(ctx context.Context) ([]service.Member, error) {
+	out := make([]service.Member, 0)
+	for _, m := range f.members {
+		if m.Deleted() {
+			out = append(out, *m)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeMembers) PersonalData(ctx context.Context, id string) (*service.PersonalData, error) {
+	m, ok := f.members[id]
+	if !ok {
+		return nil, service.ErrMemberNotFound
+	}
+	return &service.PersonalData{
+		Member: *m,
+		Record: map[string]any{"id": m.Id, "name": m.Name, "email": m.Email, "student_id": m.StudentID},
+	}, nil
+}
+
+func (f *fakeMembers) Erase(ctx context.Context, id string) error {
+	if _, ok := f.members[id]; !ok {
+		return service.ErrMemberNotFound
+	}
+	delete(f.members, id)
+	return nil
+}
+
+// fakeIdentities は member_identities の代わりにメモリ上で (provider, subject) → メンバー ID を保持する。
+type fakeIdentities struct {
+	identities map[string]*service.Identity
+}
+
+func newFakeIdentities(ids ...service.Identity) *fakeIdentities {
+	f := &fakeIdentities{identities: map[string]*service.Identity{}}
+	for _, id := range ids {
+		f.identities[id.Provider+":"+id.Subject] = &id
+	}
+	return f
+}
+
+func (f *fakeIdentities) Resolve(ctx context.Context, provider, subject string) (*service.Identity, error) {
+	id, ok := f.identities[provider+":"+subject]
+	if !ok {
+		return nil, service.ErrIdentityNotFound
+	}
+	return id, nil
+}
+
+func (f *fakeIdentities) Link(ctx context.Context, provider, subject, memberID, email string) error {
+	if id, ok := f.identities[provider+":"+subject]; ok {
+		if id.MemberID != memberID {
+			return service.ErrIdentityAlreadyLinked
+		}
+		return nil
+	}
+	f.identities[provider+":"+subject] = &service.Identity{Provider: provider, Subject: subject, MemberID: memberID, Email: email}
+	return nil
+}
+
+func (f *fakeIdentities) RecordLogin(ctx context.Context, provider, subject, email string) error {
+	id, ok := f.identities[provider+":"+subject]
+	if !ok {
+		return service.ErrIdentityNotFound
+	}
+	id.LastLoginAt = time.Now()
+	if email != "" {
+		id.Email = email
+	}
+	return nil
+}
+
+// linked は provider に対応する Member の連携フィールドを返す。
+func linked(m *service.Member, provider string) (**service.LinkedAccount, *bool) {
+	if provider == service.ProviderGitHub {
+		return &m.GitHub, &m.Accounts.Github
+	}
+	return &m.Discord, &m.Accounts.Discord
+}
+
+func (f *fakeMembers) LinkAccount(ctx context.Context, id, provider string, acc service.LinkedAccount) error {
+	for otherID, m := range f.members {
+		if other, _ := linked(m, provider); otherID != id && *other != nil && (*other).ID == acc.ID {
+			return service.ErrAccountAlreadyLinked
+		}
+	}
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	field, flag := linked(m, provider)
+	*field, *flag = &acc, true
+	return nil
+}
+
+func (f *fakeMembers) UnlinkAccount(ctx context.Context, id, provider string) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	field, flag := linked(m, provider)
+	*field, *flag = nil, false
+	return nil
+}
+
+func (f *fakeMembers) UpdateStatus(ctx context.Context, id string, from []string, to string) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	if !slices.Contains(from, m.EffectiveStatus()) {
+		return service.ErrStatusConflict
+	}
+	m.Status = to
+	return nil
+}
+
+func (f *fakeMembers) DeletePending(ctx context.Context, id string) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	if m.EffectiveStatus() != service.StatusPending {
+		return service.ErrStatusConflict
+	}
+	delete(f.members, id)
+	return nil
+}
+
+func (f *fakeMembers) ListByRole(ctx context.Context, teamID string) ([]service.Member, error) {
+	out := make([]service.Member, 0)
+	for _, m := range f.members {
+		if slices.Contains(m.RoleIDs, teamID) && !m.Deleted() {
+			out = append(out, *m)
+		}
+	}
+	return out, nil
+}
 
-func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
-	return f(req)
+func (f *fakeMembers) UpdateTags(ctx context.Context, id string, tags []string) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	m.Tags = tags
+	return nil
+}
+
+func (f *fakeMembers) UpdateSocial(ctx context.Context, id string, handles service.SocialHandles, vis service.Visibility) error {
+	m, ok := f.members[id]
+	if !ok {
+		return service.ErrMemberNotFound
+	}
+	m.Social, m.Visibility = handles, vis
+	return nil
+}
+
+func (f *fakeMembers) RecordLineLogin(ctx context.Context, id, email string) error {
+	m := f.members[id]
+	m.Accounts.Line = true
+	if email != "" {
+		m.Email = email
+	}
+	return nil
+}
+
+// signIDToken はテスト用に LINE の ID トークンを署名する。
+func signIDToken(t *testing.T, sub, nonce, email string) string {
+	t.Helper()
+	claims := line.IDTokenClaims{
+		Nonce: nonce,
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    line.Issuer,
+			Subject:   sub,
+			Audience:  jwt.ClaimStrings{"line_channel_id"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("line_channel_secret"))
+	if err != nil {
+		t.Fatalf("failed to sign id token: %v", err)
+	}
+	return s
+}
+
+// newCallbackRequest はログイン開始時の state / nonce Cookie を付けたコールバックリクエストを作る。
+func newCallbackRequest(state string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/line-oauth?code=auth_code&state="+state, nil)
+	req.AddCookie(&http.Cookie{Name: authStateCookie, Value: "state-1"})
+	req.AddCookie(&http.Cookie{Name: authNonceCookie, Value: "nonce-1"})
+	return req
+}
+
+// newLINEStub は LINE API の代わりに応答するスタブサーバを立てる。
+func newLINEStub(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestLINEConfig(baseURL string) *config.Config {
+	return &config.Config{
+		LINE: config.LINE{
+			ChannelID:     "line_channel_id",
+			ChannelSecret: "line_channel_secret",
+			RedirectURI:   "http://localhost:8080/api/line-oauth",
+			APIBaseURL:    baseURL,
+		},
+		Auth: config.Auth{JWTSecret: "test_secret", SessionTTL: time.Hour, OfficerRoles: []string{"代表"}},
+	}
 }
 
 func TestGetApiLineOauth_Success(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
-	h := NewHandler(nil, config.LINE{
-		ChannelID:     "line_channel_id",
-		ChannelSecret: "line_channel_secret",
-		RedirectURI:   "http://localhost:8080/api/line-oauth",
+	stub := newLINEStub(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/oauth2/v2.1/token":
+			if req.Method != http.MethodPost {
+				t.Fatalf("unexpected method for token endpoint: %s", req.Method)
+			}
+			b, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(b), "code=auth_code") {
+				t.Fatalf("token request does not include code: %s", string(b))
+			}
+			w.Write([]byte(`{"access_token":"access-token","expires_in":3600,"refresh_token":"refresh-token","id_token":"` + signIDToken(t, "U123", "nonce-1", "taro@example.com") + `"}`))
+		case "/v2/profile":
+			if req.Header.Get("Authorization") != "Bearer access-token" {
+				t.Fatalf("unexpected authorization header: %s", req.Header.Get("Authorization"))
+			}
+			w.Write([]byte(`{"userId":"U123","displayName":"Taro","pictureUrl":"https://example.com/pic.png","statusMessage":"hello"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+		}
 	})
 
-	h.httpClient = &http.Client{
-		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
-			switch req.URL.String() {
-			case "https://api.line.me/oauth2/v2.1/token":
-				if req.Method != http.MethodPost {
-					t.Fatalf("unexpected method for token endpoint: %s", req.Method)
-				}
-				b, _ := io.ReadAll(req.Body)
-				if !strings.Contains(string(b), "code=auth_code") {
-					t.Fatalf("token request does not include code: %s", string(b))
-				}
-				return &http.Response{
-					StatusCode: http.StatusOK,
-					Body:       io.NopCloser(strings.NewReader(`{"access_token":"access-token","expires_in":3600,"refresh_token":"refresh-token"}`)),
-					Header:     make(http.Header),
-				}, nil
-			case "https://api.line.me/v2/profile":
-				if req.Header.Get("Authorization") != "Bearer access-token" {
-					t.Fatalf("unexpected authorization header: %s", req.Header.Get("Authorization"))
-				}
-				return &http.Response{
-					StatusCode: http.StatusOK,
-					Body:       io.NopCloser(strings.NewReader(`{"userId":"U123","displayName":"Taro","pictureUrl":"https://example.com/pic.png","statusMessage":"hello"}`)),
-					Header:     make(http.Header),
-				}, nil
-			default:
-				return &http.Response{
-					StatusCode: http.StatusNotFound,
-					Body:       io.NopCloser(strings.NewReader(`{"error":"not found"}`)),
-					Header:     make(http.Header),
-				}, nil
-			}
-		}),
-	}
+	h := NewHandler(nil, newTestLINEConfig(stub.URL), Services{})
+	members := newFakeMembers(service.Member{Id: "m1", Email: "old@example.com", LineUserID: "U123"})
+	h.membersSvc = members
+	h.identitiesSvc = newFakeIdentities(service.Identity{Provider: "line", Subject: "U123", MemberID: "m1"})
 
 	r := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(r)
-	c.Request = httptest.NewRequest(http.MethodGet, "/api/line-oauth?code=auth_code", nil)
+	c.Request = newCallbackRequest("state-1")
 
-	h.GetApiLineOauth(c, api.GetApiLineOauthParams{Code: "auth_code"})
+	state := "state-1"
+	h.GetApiLineOauth(c, api.GetApiLineOauthParams{Code: "auth_code", State: &state})
 
 	if r.Code != http.StatusOK {
 		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, r.Code, r.Body.String())
 	}
 
-	var got api.LineOAuthResponse
+	// /api/auth/line/callback と同じ結果を返す
+	var got api.AuthResult
 	if err := json.Unmarshal(r.Body.Bytes(), &got); err != nil {
 		t.Fatalf("failed to parse response: %v", err)
 	}
 
-	if got.AccessToken != "access-token" {
-		t.Fatalf("unexpected access token: %s", got.AccessToken)
+	if got.Provider != "line" || got.Subject != "U123" || got.DisplayName != "Taro" {
+		t.Fatalf("unexpected auth result: %+v", got)
+	}
+	if got.MemberId == nil || *got.MemberId != "m1" {
+		t.Fatalf("unexpected member id: %v", got.MemberId)
+	}
+	if got.Email == nil || *got.Email != "taro@example.com" {
+		t.Fatalf("unexpected email: %v", got.Email)
+	}
+	// member_identities で紐づいたメンバーに、ID トークンの検証済みメールアドレスが保存される
+	if m := members.members["m1"]; m.Email != "taro@example.com" || !m.Accounts.Line {
+		t.Fatalf("line login was not recorded: %+v", m)
+	}
+	// メンバーとしてログインしたセッションが発行される
+	var session string
+	for _, ck := range r.Result().Cookies() {
+		if ck.Name == AuthCookieName {
+			session = ck.Value
+		}
+	}
+	claims, err := h.sessions.AuthenticateJWT(session)
+	if err != nil || claims.UserID != "m1" {
+		t.Fatalf("unexpected session: %v %v", claims, err)
+	}
+}
+
+func TestGetApiLineOauth_RejectsInvalidStateAndIDToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name  string
+		state string
+		nonce string // ID トークンに入れる nonce
+	}{
+		{name: "state mismatch", state: "forged", nonce: "nonce-1"},
+		{name: "nonce mismatch", state: "state-1", nonce: "replayed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newLINEStub(t, func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/oauth2/v2.1/token":
+					w.Write([]byte(`{"access_token":"access-token","expires_in":3600,"id_token":"` + signIDToken(t, "U123", tt.nonce, "") + `"}`))
+				case "/v2/profile":
+					w.Write([]byte(`{"userId":"U123","displayName":"Taro"}`))
+				}
+			})
+			h := NewHandler(nil, newTestLINEConfig(stub.URL), Services{})
+			h.membersSvc = newFakeMembers()
+			h.identitiesSvc = newFakeIdentities()
+
+			r := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(r)
+			c.Request = newCallbackRequest(tt.state)
+
+			h.GetApiLineOauth(c, api.GetApiLineOauthParams{Code: "auth_code", State: &tt.state})
+
+			if r.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, r.Code, r.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetApiLineLogin_Redirect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := NewHandler(nil, newTestLINEConfig("http://line.invalid"), Services{})
+
+	r := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(r)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/line-login", nil)
+
+	h.GetApiLineLogin(c)
+
+	if r.Code != http.StatusFound {
+		t.Fatalf("expected status %d, got %d", http.StatusFound, r.Code)
+	}
+	loc, err := url.Parse(r.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("invalid location: %v", err)
+	}
+	q := loc.Query()
+	if q.Get("scope") != "openid profile email" || q.Get("client_id") != "line_channel_id" {
+		t.Fatalf("unexpected authorize query: %s", loc.RawQuery)
+	}
+
+	cookies := map[string]string{}
+	for _, ck := range r.Result().Cookies() {
+		cookies[ck.Name] = ck.Value
 	}
-	if got.User.UserId != "U123" {
-		t.Fatalf("unexpected user id: %s", got.User.UserId)
+	if cookies[authStateCookie] != q.Get("state") || cookies[authNonceCookie] != q.Get("nonce") || q.Get("nonce") == "" {
+		t.Fatalf("state/nonce cookies do not match authorize URL: %v %s", cookies, loc.RawQuery)
+	}
+}
+
+func TestGetApiLineOauth_UpstreamErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantStatus int
+	}{
+		{name: "invalid grant", status: http.StatusBadRequest, body: `{"error":"invalid_grant","error_description":"authorization code expired"}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid client", status: http.StatusUnauthorized, body: `{"error":"invalid_client"}`, wantStatus: http.StatusInternalServerError},
+		{name: "server error", status: http.StatusServiceUnavailable, body: `upstream down`, wantStatus: http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newLINEStub(t, func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			h := NewHandler(nil, newTestLINEConfig(stub.URL), Services{})
+
+			r := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(r)
+			c.Request = newCallbackRequest("state-1")
+
+			state := "state-1"
+			h.GetApiLineOauth(c, api.GetApiLineOauthParams{Code: "auth_code", State: &state})
+
+			if r.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d, body=%s", tt.wantStatus, r.Code, r.Body.String())
+			}
+			// 上流のレスポンス本文はそのまま返さない
+			if strings.Contains(r.Body.String(), tt.body) {
+				t.Fatalf("upstream body leaked: %s", r.Body.String())
+			}
+		})
 	}
 }
 
 func TestGetApiLineOauth_MissingConfig(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
-	h := NewHandler(nil, config.LINE{})
+	h := NewHandler(nil, &config.Config{}, Services{})
 
 	r := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(r)
@@ -101,3 +548,23 @@ func TestGetApiLineOauth_MissingConfig(t *testing.T) {
 		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, r.Code)
 	}
 }
+
+func TestPutApiProfileBasicInfo_ValidatesEnrollment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, _, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	base := `"student_id":"B1234567","faculty":"情報工学部","last_name":"田中","first_name":"太郎","nickname":"たなたろ","self_introduction":"","visibility":{}`
+	for _, body := range []string{
+		`{` + base + `,"enrollment_year":1999}`,
+		`{` + base + `,"enrollment_year":` + strconv.Itoa(service.AcademicYear(time.Now())+2) + `}`,
+		`{` + base + `,"program_years":0}`,
+		`{` + base + `,"program_years":10}`,
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPut, "/api/profile/basic-info", body, ""))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d: %s", body, w.Code, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportBytes は一括登録で受け付ける CSV の大きさ（1 MiB）
+const maxImportBytes = 1 << 20
+
+// PostApiMembersImport は CSV からメンバーをまとめて登録する（役員のみ）。
+// dry_run（既定）では行ごとの確認結果だけを返し、dry_run=false ではエラーのない行だけを登録する。
+// 重複は登録時にも行ごとに確かめ、その間に登録された学籍番号の行は invalid として返す。
+func (h *Handler) PostApiMembersImport(c *gin.Context, params api.PostApiMembersImportParams) {
+	ctx := c.Request.Context()
+	dryRun := params.DryRun == nil || *params.DryRun
+
+	// --- ① CSV を読んで行ごとに確認 ---
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBytes)
+	rows, err := service.ParseImportCSV(body, time.Now())
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "csv must be at most 1 MiB"})
+		case errors.Is(err, service.ErrInvalidCSV):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// --- ② 学籍番号の重複と班・役職を確認 ---
+	existing, err := h.membersSvc.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	service.CheckImportDuplicates(rows, existing)
+	teams, err := h.teamsSvc.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for i := range rows {
+		resolved, err := service.ResolveTeams(teams, rows[i].RoleRefs)
+		if err != nil {
+			rows[i].AddError("%v", err)
+			continue
+		}
+		rows[i].Member.SetTeams(resolved)
+	}
+
+	report := api.ImportReport{DryRun: dryRun, Total: len(rows), Rows: make([]api.ImportRowResult, 0, len(rows))}
+	valid := make([]int, 0, len(rows))
+	for i, r := range rows {
+		result := api.ImportRowResult{Line: r.Line, Name: &rows[i].Member.Name, StudentId: &rows[i].Member.StudentID, Errors: r.Errors, Status: api.Valid}
+		if !r.Valid() {
+			result.Status = api.Invalid
+		} else {
+			valid = append(valid, i)
+		}
+		if result.Errors == nil {
+			result.Errors = []string{}
+		}
+		report.Rows = append(report.Rows, result)
+	}
+	report.Valid = len(valid)
+	if dryRun || len(valid) == 0 {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+
+	// --- ③ エラーのない行だけを登録 ---
+	members := make([]service.Member, 0, len(valid))
+	for _, i := range valid {
+		members = append(members, rows[i].Member)
+	}
+	ids, errs := h.membersSvc.Import(ctx, members)
+	for j, i := range valid {
+		switch err := errs[j]; {
+		case err == nil:
+			report.Rows[i].Status = api.Created
+			report.Rows[i].Id = &ids[j]
+			report.Created++
+			continue
+		case errors.Is(err, service.ErrStudentIDTaken), errors.Is(err, service.ErrLineUserIDTaken):
+			// 確認の後に別のリクエストで同じ学籍番号が登録された
+			report.Rows[i].Errors = append(report.Rows[i].Errors, err.Error())
+		default:
+			logging.FromContext(ctx).Error("failed to import member", "line", rows[i].Line, "error", err)
+			report.Rows[i].Errors = append(report.Rows[i].Errors, "failed to save")
+		}
+		report.Rows[i].Status = api.Invalid
+	}
+	if report.Created > 0 {
+		h.searchIndex.Invalidate()
+	}
+	logging.FromContext(ctx).Info("members imported", "created", report.Created, "rows", report.Total, "by", currentMemberID(c))
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPostApiMembersImport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-regular"].StudentID = "23T0001"
+	router := newLinkRouter(h)
+
+	csv := "name,nickname,student_id,department,year,roles\n" +
+		"田中 太郎,たなたろ,24T0001,情報工学部,1,web班\n" +
+		"鈴木 花子,はな,23t0001,理学部,2,\n" +
+		"佐藤 次郎,じろ,24T0002,工学部,1,存在しない班\n"
+
+	do := func(path, member string) (int, api.ImportReport) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, path, csv, member))
+		var report api.ImportReport
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code, report
+	}
+
+	if code, _ := do("/api/members/import", "m-regular"); code != http.StatusForbidden {
+		t.Fatalf("expected 403 for non-officers, got %d", code)
+	}
+
+	// 既定は dry run で、何も登録しない
+	code, report := do("/api/members/import", "m-officer")
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if !report.DryRun || report.Total != 3 || report.Valid != 1 || report.Created != 0 || len(members.members) != 2 {
+		t.Fatalf("unexpected dry run report: %+v", report)
+	}
+	if report.Rows[0].Status != api.Valid || report.Rows[1].Status != api.Invalid || report.Rows[2].Status != api.Invalid {
+		t.Fatalf("unexpected row statuses: %+v", report.Rows)
+	}
+	if !strings.Contains(report.Rows[1].Errors[0], "already registered") || !strings.Contains(report.Rows[2].Errors[0], "存在しない班") {
+		t.Fatalf("unexpected row errors: %+v", report.Rows)
+	}
+
+	// dry_run=false でエラーのない行だけを登録する
+	code, report = do("/api/members/import?dry_run=false", "m-officer")
+	if code != http.StatusOK || report.DryRun || report.Created != 1 {
+		t.Fatalf("unexpected import report (%d): %+v", code, report)
+	}
+	if report.Rows[0].Status != api.Created || report.Rows[0].Id == nil {
+		t.Fatalf("first row should be created: %+v", report.Rows[0])
+	}
+	created := members.members[*report.Rows[0].Id]
+	if created.StudentID != "24T0001" || created.Status != service.StatusActive || len(created.RoleIDs) != 1 || created.Roles[0] != "Web班" {
+		t.Fatalf("unexpected imported member: %+v", created)
+	}
+
+	// 同じ CSV をもう一度送っても重複して登録しない
+	_, report = do("/api/members/import?dry_run=false", "m-officer")
+	if report.Created != 0 || report.Rows[0].Status != api.Invalid {
+		t.Fatalf("re-importing should not create duplicates: %+v", report)
+	}
+}
+
+// racingMembers は一覧を返した直後に同じ学籍番号のメンバーが登録されたように振る舞う
+type racingMembers struct {
+	*fakeMembers
+	racer service.Member
+}
+
+func (r *racingMembers) List(ctx context.Context) ([]service.Member, error) {
+	list, err := r.fakeMembers.List(ctx)
+	r.members[r.racer.Id] = &r.racer
+	return list, err
+}
+
+func TestPostApiMembersImport_TakenWhileImporting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	h.membersSvc = &racingMembers{fakeMembers: members, racer: service.Member{Id: "m-racer", Name: "先に登録", StudentID: "24T0001"}}
+	router := newLinkRouter(h)
+
+	csv := "name,student_id\n田中 太郎,24T0001\n鈴木 花子,24T0002\n"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members/import?dry_run=false", csv, "m-officer"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var report api.ImportReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	// 確認の後に登録された学籍番号の行は、登録時の確認で invalid になる
+	if report.Created != 1 || report.Rows[0].Status != api.Invalid || report.Rows[1].Status != api.Created {
+		t.Fatalf("unexpected import report: %+v", report)
+	}
+	if len(report.Rows[0].Errors) != 1 || !strings.Contains(report.Rows[0].Errors[0], "24T0001") {
+		t.Fatalf("unexpected row errors: %+v", report.Rows[0].Errors)
+	}
+}
+
+func TestPostApiMembersImport_InvalidCSV(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, _, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members/import", "nickname\nたなたろ\n", "m-officer"))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without required columns, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members/import", "name,student_id\n"+strings.Repeat("a", maxImportBytes), "m-officer"))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413 for a large file, got %d", w.Code)
+	}
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultInvitationMaxUses = 1
+	maxInvitationMaxUses     = 100
+	defaultInvitationTTL     = 7 * 24 * time.Hour
+	maxInvitationTTL         = 90 * 24 * time.Hour
+)
+
+// invitationsService は Handler が使う招待コードの操作。
+type invitationsService interface {
+	Create(ctx context.Context, inv service.Invitation) (*service.Invitation, error)
+	List(ctx context.Context) ([]service.Invitation, error)
+	Redeem(ctx context.Context, code string, m service.Member, provider, subject string) (string, error)
+}
+
+// GetApiAdminInvitations は発行済みの招待コードを返す（役員のみ）。
+func (h *Handler) GetApiAdminInvitations(c *gin.Context, params api.GetApiAdminInvitationsParams) {
+	ctx := c.Request.Context()
+
+	if params.Status != nil {
+		switch *params.Status {
+		case service.InvitationOutstanding, service.InvitationRedeemed, service.InvitationExpired:
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be outstanding, redeemed or expired"})
+			return
+		}
+	}
+
+	invitations, err := h.invitationsSvc.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	now := time.Now()
+	out := make([]api.Invitation, 0, len(invitations))
+	for _, inv := range invitations {
+		res := inv.ToAPI(now)
+		if params.Status != nil && res.Status != *params.Status {
+			continue
+		}
+		out = append(out, res)
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// PostApiAdminInvitations は招待コードを発行する（役員のみ）。
+func (h *Handler) PostApiAdminInvitations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// --- ① リクエストボディをパースして範囲を確認 ---
+	var req api.InvitationCreate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	maxUses := defaultInvitationMaxUses
+	if req.MaxUses != nil {
+		maxUses = *req.MaxUses
+	}
+	if maxUses < 1 || maxUses > maxInvitationMaxUses {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "max_uses must be between 1 and 100"})
+		return
+	}
+	ttl := defaultInvitationTTL
+	if req.ExpiresInHours != nil {
+		ttl = time.Duration(*req.ExpiresInHours) * time.Hour
+	}
+	if ttl < time.Hour || ttl > maxInvitationTTL {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expires_in_hours must be between 1 and 2160"})
+		return
+	}
+
+	// --- ② 発行 ---
+	inv := service.Invitation{
+		MaxUses:   maxUses,
+		ExpiresAt: time.Now().Add(ttl),
+		CreatedBy: currentMemberID(c),
+	}
+	if req.Roles != nil {
+		teams, ok := h.resolveTeams(c, *req.Roles)
+		if !ok {
+			return
+		}
+		for _, t := range teams {
+			inv.RoleIDs = append(inv.RoleIDs, t.Id)
+			inv.Roles = append(inv.Roles, t.Name)
+		}
+	}
+	if req.Year != nil {
+		inv.Year = *req.Year
+	}
+	if req.Note != nil {
+		inv.Note = *req.Note
+	}
+	created, err := h.invitationsSvc.Create(ctx, inv)
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to create invitation", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logging.FromContext(ctx).Info("invitation created", "by", inv.CreatedBy, "max_uses", maxUses, "expires_at", created.ExpiresAt)
+
+	c.JSON(http.StatusCreated, created.ToAPI(time.Now()))
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeInvitations はメモリ上で招待コードを保持し、Redeem で fakeMembers / fakeIdentities に登録する。
+type fakeInvitations struct {
+	invitations map[string]*service.Invitation
+	members     *fakeMembers
+	identities  *fakeIdentities
+}
+
+func (f *fakeInvitations) Create(ctx context.Context, inv service.Invitation) (*service.Invitation, error) {
+	inv.Code = "CODE" + string(rune('A'+len(f.invitations)))
+	inv.CreatedAt = time.Now()
+	f.invitations[inv.Code] = &inv
+	return &inv, nil
+}
+
+func (f *fakeInvitations) List(ctx context.Context) ([]service.Invitation, error) {
+	out := make([]service.Invitation, 0, len(f.invitations))
+	for _, inv := range f.invitations {
+		out = append(out, *inv)
+	}
+	return out, nil
+}
+
+func (f *fakeInvitations) Redeem(ctx context.Context, code string, m service.Member, provider, subject string) (string, error) {
+	inv, ok := f.invitations[service.NormalizeInvitationCode(code)]
+	if !ok || inv.Status(time.Now()) != service.InvitationOutstanding {
+		return "", service.ErrInvitationInvalid
+	}
+	if _, err := f.identities.Resolve(ctx, provider, subject); err == nil {
+		return "", service.ErrIdentityAlreadyLinked
+	}
+	m = inv.Apply(m, provider, subject, time.Now())
+	id, err := f.members.Register(ctx, m)
+	if err != nil {
+		return "", err
+	}
+	f.identities.Link(ctx, provider, subject, id, "")
+	inv.Uses++
+	inv.Redemptions = append(inv.Redemptions, service.Redemption{MemberID: id, RedeemedAt: time.Now()})
+	return id, nil
+}
+
+// newInvitationTestHandler は役員 m-officer と一般メンバー m-regular がいる Handler を用意する。
+func newInvitationTestHandler(t *testing.T) (*Handler, *fakeMembers, *fakeInvitations) {
+	t.Helper()
+	h := NewHandler(nil, newTestLINEConfig(""), Services{})
+	members := newFakeMembers(
+		service.Member{Id: "m-officer", RoleIDs: []string{"leader"}, Roles: []string{"代表"}},
+		service.Member{Id: "m-regular", RoleIDs: []string{"web"}, Roles: []string{"Web班"}},
+	)
+	identities := newFakeIdentities()
+	invitations := &fakeInvitations{invitations: map[string]*service.Invitation{}, members: members, identities: identities}
+	teams := newFakeTeams(members,
+		service.Team{Id: "leader", Name: "代表", Kind: service.TeamKindOfficer},
+		service.Team{Id: "web", Name: "Web班", Kind: service.TeamKindTeam, Order: 10},
+	)
+	h.membersSvc, h.identitiesSvc, h.invitationsSvc, h.teamsSvc = members, identities, invitations, teams
+	h.termsSvc = newFakeTerms()
+	return h, members, invitations
+}
+
+// withCookies は Handler で発行した Cookie（セッション・登録用トークン）をリクエストに付ける。
+func withCookies(t *testing.T, req *http.Request, issue func(c *gin.Context) error) *http.Request {
+	t.Helper()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if err := issue(c); err != nil {
+		t.Fatal(err)
+	}
+	for _, ck := range w.Result().Cookies() {
+		req.AddCookie(ck)
+	}
+	return req
+}
+
+func TestPostApiAdminInvitations_OfficersOnly(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, _, invitations := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	newReq := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/invitations", bytes.NewBufferString(`{"max_uses":2,"roles":["Web班"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	for _, tc := range []struct {
+		member string
+		want   int
+	}{
+		{member: "", want: http.StatusUnauthorized},
+		{member: "m-regular", want: http.StatusForbidden},
+		{member: "m-officer", want: http.StatusCreated},
+	} {
+		req := newReq()
+		if tc.member != "" {
+			req = withCookies(t, req, func(c *gin.Context) error { return h.issueSession(c, tc.member) })
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.want {
+			t.Fatalf("member %q: expected %d, got %d, body=%s", tc.member, tc.want, w.Code, w.Body.String())
+		}
+	}
+
+	if len(invitations.invitations) != 1 {
+		t.Fatalf("expected one invitation, got %d", len(invitations.invitations))
+	}
+	for _, inv := range invitations.invitations {
+		if inv.MaxUses != 2 || inv.CreatedBy != "m-officer" || inv.ExpiresAt.Before(time.Now().Add(6*24*time.Hour)) {
+			t.Fatalf("unexpected invitation: %+v", inv)
+		}
+	}
+}
+
+func TestPostApiMembers_RedeemsInvitation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, invitations := newInvitationTestHandler(t)
+	invitations.invitations["K7MZQ4PXWA"] = &service.Invitation{
+		Code: "K7MZQ4PXWA", MaxUses: 1, ExpiresAt: time.Now().Add(time.Hour), Roles: []string{"Web班"},
+	}
+	router := newLinkRouter(h)
+
+	post := func(code string, onboarding bool) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]any{
+			"name": "山田 花子", "nickname": "はなこ", "department": "情報工学部", "year": "1年生", "bio": "",
+			"roles": []string{"代表"}, "accounts": map[string]bool{"line": false, "discord": false, "github": false},
+			"invitation_code": code,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/api/members", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if onboarding {
+			req = withCookies(t, req, func(c *gin.Context) error { return h.issueOnboarding(c, "line", "U999") })
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post("K7MZQ4PXWA", false); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without onboarding, got %d", w.Code)
+	}
+	if w := post("WRONGCODE", true); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for unknown code, got %d", w.Code)
+	}
+
+	w := post("k7mz-q4px-wa", true)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d, body=%s", w.Code, w.Body.String())
+	}
+	var got api.MemberCreateResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	// 役職は招待コードのものが使われ、自分で「代表」にはなれない。役員の承認までは pending
+	if m := members.members[got.Id]; m == nil || len(m.Roles) != 1 || m.Roles[0] != "Web班" || m.Status != service.StatusPending {
+		t.Fatalf("unexpected member: %+v", m)
+	}
+	var session string
+	for _, ck := range w.Result().Cookies() {
+		if ck.Name == AuthCookieName {
+			session = ck.Value
+		}
+	}
+	if claims, err := h.sessions.AuthenticateJWT(session); err != nil || claims.UserID != got.Id {
+		t.Fatalf("member was not logged in: %v %v", claims, err)
+	}
+
+	// 使用回数の上限に達したコードはもう使えない
+	if w := post("K7MZQ4PXWA", true); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for used code, got %d", w.Code)
+	}
+
+}
+
+func TestPostApiMembers_RejectsDuplicateLINEUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, invitations := newInvitationTestHandler(t)
+	invitations.invitations["TWOUSES234"] = &service.Invitation{Code: "TWOUSES234", MaxUses: 2, ExpiresAt: time.Now().Add(time.Hour)}
+	router := newLinkRouter(h)
+
+	post := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/members", bytes.NewBufferString(`{"name":"山田 花子","nickname":"","department":"","year":"","bio":"","roles":[],"accounts":{"line":false,"discord":false,"github":false},"invitation_code":"TWOUSES234"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req = withCookies(t, req, func(c *gin.Context) error { return h.issueOnboarding(c, "line", "U777") })
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := post()
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d, body=%s", w.Code, w.Body.String())
+	}
+	var got api.MemberCreateResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if m := members.members[got.Id]; m == nil || m.LineUserID != "U777" {
+		t.Fatalf("line_user_id was not recorded: %+v", m)
+	}
+
+	// member_identities の紐づけが無くなっていても、同じ LINE ユーザーでは 2 人目を登録できない
+	delete(invitations.identities.identities, "line:U777")
+	w = post()
+	if w.Code != http.StatusConflict || !strings.Contains(w.Body.String(), service.ErrLineUserIDTaken.Error()) {
+		t.Fatalf("expected 409 for duplicate LINE user, got %d, body=%s", w.Code, w.Body.String())
+	}
+	if len(members.members) != 3 {
+		t.Fatalf("duplicate member was registered: %d members", len(members.members))
+	}
+}
+
+func TestPostApiMembers_IgnoresRequestedRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, invitations := newInvitationTestHandler(t)
+	invitations.invitations["NOROLES234"] = &service.Invitation{Code: "NOROLES234", MaxUses: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	router := newLinkRouter(h)
+
+	// 役職のない招待コードでも、リクエストの「代表」は付かない
+	req := httptest.NewRequest(http.MethodPost, "/api/members", bytes.NewBufferString(`{"name":"山田 花子","nickname":"","department":"","year":"","bio":"","roles":["代表"],"accounts":{"line":false,"discord":false,"github":false},"invitation_code":"NOROLES234"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req = withCookies(t, req, func(c *gin.Context) error { return h.issueOnboarding(c, "line", "U999") })
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d, body=%s", w.Code, w.Body.String())
+	}
+	var got api.MemberCreateResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if m := members.members[got.Id]; m == nil || len(m.Roles) != 0 || len(m.RoleIDs) != 0 {
+		t.Fatalf("self-registered member must not get roles from the request: %+v", m)
+	}
+	if ok, _ := h.isOfficer(req.Context(), got.Id); ok {
+		t.Fatal("self-registered member must not be an officer")
+	}
+}
+
+func TestSession_IgnoresOnboardingToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, _, _ := newInvitationTestHandler(t)
+
+	// 登録用トークンを auth_token として送ってもログイン扱いにはならない
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if err := h.issueOnboarding(c, "line", "U999"); err != nil {
+		t.Fatal(err)
+	}
+	token := w.Result().Cookies()[0].Value
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/invitations", nil)
+	req.AddCookie(&http.Cookie{Name: AuthCookieName, Value: token})
+	rec := httptest.NewRecorder()
+	newLinkRouter(h).ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
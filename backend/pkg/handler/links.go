@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// アカウント連携の開始からコールバックまで state を保持する Cookie
+	linkStateCookiePrefix = "link_oauth_state_"
+	linkCookiePath        = "/api/me/links"
+	// GitHub のプロフィール URL を links に載せるかを、開始からコールバックまで保持する Cookie
+	githubShowOnProfileCookie = "link_github_show_on_profile"
+)
+
+// GetApiMeLinksDiscordStart はログイン中のメンバーについて Discord の認可画面へリダイレクトする。
+func (h *Handler) GetApiMeLinksDiscordStart(c *gin.Context) {
+	if !h.discord.Configured() {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Discord OAuth settings are not configured"})
+		return
+	}
+	state, err := h.startLink(c, service.ProviderDiscord)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Redirect(http.StatusFound, h.discord.AuthorizeURL(state))
+}
+
+// GetApiMeLinksDiscordCallback は Discord アカウントをログイン中のメンバーに紐づける。
+func (h *Handler) GetApiMeLinksDiscordCallback(c *gin.Context, params api.GetApiMeLinksDiscordCallbackParams) {
+	ctx := c.Request.Context()
+
+	// --- ① 連携開始時に発行した state を照合（CSRF 対策） ---
+	if !h.verifyLinkState(c, service.ProviderDiscord, params.State) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state"})
+		return
+	}
+
+	// --- ② 認可コードから Discord ユーザーを取得 ---
+	acc, err := h.discord.FetchAccount(ctx, params.Code)
+	if err != nil {
+		respondLinkError(c, service.ProviderDiscord, err)
+		return
+	}
+
+	// --- ③ メンバーに紐づけ（accounts.discord も true になる） ---
+	h.linkAccount(c, service.ProviderDiscord, service.LinkedAccount{
+		ID:       acc.ID,
+		Username: acc.Username,
+		LinkedAt: time.Now(),
+	})
+}
+
+// DeleteApiMeLinksDiscord はログイン中のメンバーの Discord 連携を解除する。
+func (h *Handler) DeleteApiMeLinksDiscord(c *gin.Context) {
+	h.unlinkAccount(c, service.ProviderDiscord)
+}
+
+// GetApiMeLinksGithubStart はログイン中のメンバーについて GitHub の認可画面へリダイレクトする。
+func (h *Handler) GetApiMeLinksGithubStart(c *gin.Context, params api.GetApiMeLinksGithubStartParams) {
+	if !h.github.Configured() {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "GitHub OAuth settings are not configured"})
+		return
+	}
+	state, err := h.startLink(c, service.ProviderGitHub)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	// 既定ではプロフィール URL を links に載せる
+	show := "1"
+	if params.ShowOnProfile != nil && !*params.ShowOnProfile {
+		show = "0"
+	}
+	c.SetCookie(githubShowOnProfileCookie, show, lineLoginCookieMaxAge, linkCookiePath, "", false, true)
+	c.Redirect(http.StatusFound, h.github.AuthorizeURL(state))
+}
+
+// GetApiMeLinksGithubCallback は GitHub アカウント（login と ID）をログイン中のメンバーに紐づける。
+func (h *Handler) GetApiMeLinksGithubCallback(c *gin.Context, params api.GetApiMeLinksGithubCallbackParams) {
+	ctx := c.Request.Context()
+
+	// --- ① 連携開始時に発行した state を照合（CSRF 対策） ---
+	if !h.verifyLinkState(c, service.ProviderGitHub, params.State) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state"})
+		return
+	}
+	show, _ := c.Cookie(githubShowOnProfileCookie)
+	c.SetCookie(githubShowOnProfileCookie, "", -1, linkCookiePath, "", false, true)
+
+	// --- ② 認可コードから GitHub ユーザーを取得 ---
+	acc, err := h.github.FetchAccount(ctx, params.Code)
+	if err != nil {
+		respondLinkError(c, service.ProviderGitHub, err)
+		return
+	}
+
+	// --- ③ メンバーに紐づけ（accounts.github も true になる） ---
+	h.linkAccount(c, service.ProviderGitHub, service.LinkedAccount{
+		ID:            acc.ID,
+		Username:      acc.Username,
+		LinkedAt:      time.Now(),
+		ProfileURL:    acc.ProfileURL,
+		ShowOnProfile: show != "0",
+	})
+}
+
+// DeleteApiMeLinksGithub はログイン中のメンバーの GitHub 連携を解除する。
+func (h *Handler) DeleteApiMeLinksGithub(c *gin.Context) {
+	h.unlinkAccount(c, service.ProviderGitHub)
+}
+
+// startLink は連携用の state を発行して Cookie に保存する。
+func (h *Handler) startLink(c *gin.Context, provider string) (string, error) {
+	state, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(linkStateCookiePrefix+provider, state, lineLoginCookieMaxAge, linkCookiePath, "", false, true)
+	return state, nil
+}
+
+// verifyLinkState はコールバックの state を Cookie と照合する。state は 1 回限りなので Cookie は消す。
+func (h *Handler) verifyLinkState(c *gin.Context, provider string, got *string) bool {
+	want, _ := c.Cookie(linkStateCookiePrefix + provider)
+	c.SetCookie(linkStateCookiePrefix+provider, "", -1, linkCookiePath, "", false, true)
+	return want != "" && got != nil && subtle.ConstantTimeCompare([]byte(want), []byte(*got)) == 1
+}
+
+// respondLinkError は連携先のアカウント取得のエラーをレスポンスに変換する。
+func respondLinkError(c *gin.Context, provider string, err error) {
+	logging.FromContext(c.Request.Context()).Warn(provider+" user fetch failed", "error", err)
+	switch {
+	case errors.Is(err, identity.ErrInvalidGrant):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid or expired authorization code"})
+	case errors.Is(err, identity.ErrNotConfigured):
+		c.JSON(http.StatusInternalServerError, gin.H{"error": provider + " OAuth settings are not configured"})
+	default:
+		c.JSON(http.StatusBadGateway, gin.H{"error": provider + " user fetch failed"})
+	}
+}
+
+// linkAccount は外部アカウントをログイン中のメンバーに紐づけてレスポンスを返す。
+func (h *Handler) linkAccount(c *gin.Context, provider string, acc service.LinkedAccount) {
+	ctx := c.Request.Context()
+	memberID := currentMemberID(c)
+
+	err := h.membersSvc.LinkAccount(ctx, memberID, provider, acc)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrAccountAlreadyLinked):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, service.ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	default:
+		logging.FromContext(ctx).Error("failed to link account", "provider", provider, "member", memberID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res := api.AccountLink{
+		Provider:  provider,
+		AccountId: acc.ID,
+		Username:  acc.Username,
+	}
+	if acc.ProfileURL != "" {
+		res.ProfileUrl = &acc.ProfileURL
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// unlinkAccount はログイン中のメンバーから provider の連携を外す。
+func (h *Handler) unlinkAccount(c *gin.Context, provider string) {
+	ctx := c.Request.Context()
+	memberID := currentMemberID(c)
+
+	err := h.membersSvc.UnlinkAccount(ctx, memberID, provider)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to unlink account", "provider", provider, "member", memberID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// newDiscordStub は Discord のトークン・ユーザー API を模したサーバを立て、それを向いた設定を返す。
+func newDiscordStub(t *testing.T, userID string) config.OAuthProvider {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"at","token_type":"Bearer","expires_in":604800}`))
+	})
+	mux.HandleFunc("/api/users/@me", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"` + userID + `","username":"tanataro"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return config.OAuthProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  "http://localhost:8080/api/me/links/discord/callback",
+		TokenURL:     srv.URL + "/api/oauth2/token",
+		APIBaseURL:   srv.URL + "/api",
+	}
+}
+
+// newLinkRouter はログイン必須チェック込みでハンドラを登録したルーターを返す。
+func newLinkRouter(h *Handler) *gin.Engine {
+	router := gin.New()
+	router.Use(h.Session)
+	api.RegisterHandlersWithOptions(router, h, api.GinServerOptions{
+		Middlewares: []api.MiddlewareFunc{h.RequireAuth},
+	})
+	return router
+}
+
+// newLinkCallbackRequest は state Cookie と（あれば）セッション Cookie 付きのコールバックリクエストを作る。
+func newLinkCallbackRequest(t *testing.T, h *Handler, provider, memberID string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/me/links/"+provider+"/callback?code=good&state=s1", nil)
+	req.AddCookie(&http.Cookie{Name: linkStateCookiePrefix + provider, Value: "s1"})
+	if memberID != "" {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if err := h.issueSession(c, memberID); err != nil {
+			t.Fatal(err)
+		}
+		for _, ck := range w.Result().Cookies() {
+			req.AddCookie(ck)
+		}
+	}
+	return req
+}
+
+func TestGetApiMeLinksDiscordCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := newTestLINEConfig("")
+	cfg.Discord = newDiscordStub(t, "8035")
+	h := NewHandler(nil, cfg, Services{})
+	members := newFakeMembers(
+		service.Member{Id: "m1"},
+		service.Member{Id: "m2", Discord: &service.LinkedAccount{ID: "8035"}},
+	)
+	h.membersSvc = members
+	router := newLinkRouter(h)
+
+	t.Run("requires login", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newLinkCallbackRequest(t, h, service.ProviderDiscord, ""))
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401, got %d", w.Code)
+		}
+	})
+
+	t.Run("already linked to another member", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newLinkCallbackRequest(t, h, service.ProviderDiscord, "m1"))
+		if w.Code != http.StatusConflict {
+			t.Fatalf("expected 409, got %d, body=%s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("links the account", func(t *testing.T) {
+		members.members["m2"].Discord = nil
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newLinkCallbackRequest(t, h, service.ProviderDiscord, "m1"))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d, body=%s", w.Code, w.Body.String())
+		}
+		var got api.AccountLink
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Provider != "discord" || got.AccountId != "8035" || got.Username != "tanataro" {
+			t.Fatalf("unexpected response: %+v", got)
+		}
+		if m := members.members["m1"]; m.Discord == nil || !m.Accounts.Discord {
+			t.Fatalf("account was not linked: %+v", m)
+		}
+	})
+
+	t.Run("rejects state mismatch", func(t *testing.T) {
+		req := newLinkCallbackRequest(t, h, service.ProviderDiscord, "m1")
+		req.URL.RawQuery = "code=good&state=other"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestGetApiMeLinksGithubCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		w.Write([]byte("access_token=gho_at&token_type=bearer"))
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":583231,"login":"octocat","html_url":"https://github.com/octocat"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	cfg := newTestLINEConfig("")
+	cfg.GitHub = config.OAuthProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  "http://localhost:8080/api/me/links/github/callback",
+		TokenURL:     srv.URL + "/login/oauth/access_token",
+		APIBaseURL:   srv.URL,
+	}
+	h := NewHandler(nil, cfg, Services{})
+	members := newFakeMembers(service.Member{Id: "m1"})
+	h.membersSvc = members
+	router := newLinkRouter(h)
+
+	for _, tc := range []struct {
+		name string
+		show string
+		want bool
+	}{
+		{name: "shown by default", show: "", want: true},
+		{name: "hidden on request", show: "0", want: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newLinkCallbackRequest(t, h, service.ProviderGitHub, "m1")
+			if tc.show != "" {
+				req.AddCookie(&http.Cookie{Name: githubShowOnProfileCookie, Value: tc.show})
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d, body=%s", w.Code, w.Body.String())
+			}
+			var got api.AccountLink
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.AccountId != "583231" || got.Username != "octocat" || got.ProfileUrl == nil {
+				t.Fatalf("unexpected response: %+v", got)
+			}
+			m := members.members["m1"]
+			if m.GitHub == nil || !m.Accounts.Github || m.GitHub.ShowOnProfile != tc.want {
+				t.Fatalf("unexpected github link: %+v", m.GitHub)
+			}
+		})
+	}
+}
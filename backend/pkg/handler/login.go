@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/line"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	// 汎用ログインの開始からコールバックまで state / nonce を保持する Cookie（パスはプロバイダごと）
+	authStateCookie = "auth_state"
+	authNonceCookie = "auth_nonce"
+)
+
+// identitiesService は Handler が使う member_identities の操作。
+type identitiesService interface {
+	Resolve(ctx context.Context, provider, subject string) (*service.Identity, error)
+	Link(ctx context.Context, provider, subject, memberID, email string) error
+	RecordLogin(ctx context.Context, provider, subject, email string) error
+}
+
+// GetApiAuthProviderStart は指定したプロバイダの認可画面へリダイレクトする。
+func (h *Handler) GetApiAuthProviderStart(c *gin.Context, provider string) {
+	p, ok := h.providers[provider]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown identity provider"})
+		return
+	}
+	if !p.Configured() {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": provider + " login settings are not configured"})
+		return
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// プロバイダからのリダイレクト（トップレベルの GET）でも送られるよう Lax にする
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(authStateCookie, state, lineLoginCookieMaxAge, authCookiePath(provider), "", false, true)
+	c.SetCookie(authNonceCookie, nonce, lineLoginCookieMaxAge, authCookiePath(provider), "", false, true)
+
+	c.Redirect(http.StatusFound, p.AuthorizeURL(state, nonce))
+}
+
+// GetApiAuthProviderCallback はプロバイダのユーザーに対応するメンバーとしてログインさせる。
+// 対応するメンバーがいなくてもログイン中なら、そのメンバーにこのプロバイダの ID を追加する。
+func (h *Handler) GetApiAuthProviderCallback(c *gin.Context, provider string, params api.GetApiAuthProviderCallbackParams) {
+	ctx := c.Request.Context()
+
+	p, ok := h.providers[provider]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown identity provider"})
+		return
+	}
+	if !p.Configured() {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": provider + " login settings are not configured"})
+		return
+	}
+
+	// --- ① ログイン開始時に発行した state を照合（CSRF 対策） ---
+	// state / nonce は 1 回限りなので、結果にかかわらず Cookie は消す
+	state, _ := c.Cookie(authStateCookie)
+	nonce, _ := c.Cookie(authNonceCookie)
+	c.SetCookie(authStateCookie, "", -1, authCookiePath(provider), "", false, true)
+	c.SetCookie(authNonceCookie, "", -1, authCookiePath(provider), "", false, true)
+	if state == "" || nonce == "" || params.State == nil || subtle.ConstantTimeCompare([]byte(state), []byte(*params.State)) != 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state"})
+		return
+	}
+
+	// --- ② 認可コードをトークンに交換し、本人確認済みのユーザー情報を取得 ---
+	token, err := p.Exchange(ctx, params.Code)
+	if err != nil {
+		respondIdentityError(c, provider+" token exchange failed", err)
+		return
+	}
+	info, err := p.UserInfo(ctx, token, nonce)
+	if err != nil {
+		respondIdentityError(c, provider+" user info fetch failed", err)
+		return
+	}
+
+	// --- ③ (provider, subject) に対応するメンバーを探す ---
+	id, err := h.identitiesSvc.Resolve(ctx, provider, info.Subject)
+	var memberID string
+	switch {
+	case err == nil:
+		memberID = id.MemberID
+	case errors.Is(err, service.ErrIdentityNotFound):
+		// ログイン中なら、そのメンバーのログイン手段として追加する
+		if current := currentMemberID(c); current != "" {
+			err := h.identitiesSvc.Link(ctx, provider, info.Subject, current, info.Email)
+			if errors.Is(err, service.ErrIdentityAlreadyLinked) || errors.Is(err, service.ErrLineUserIDTaken) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+			if err != nil {
+				logging.FromContext(ctx).Error("failed to link identity", "provider", provider, "member", current, "error", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			memberID = current
+		}
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// --- ④ メンバーがいればログイン記録を残してセッションを発行。いなければ招待コードでの登録に進めるようにする ---
+	if memberID == "" {
+		if err := h.issueOnboarding(c, provider, info.Subject); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	} else {
+		if suspended, err := h.isSuspended(ctx, memberID); err != nil || suspended {
+			respondSuspended(c, err)
+			return
+		}
+		if err := h.recordLogin(ctx, provider, info, memberID); err != nil {
+			logging.FromContext(ctx).Error("failed to record login", "provider", provider, "member", memberID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := h.issueSession(c, memberID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	res := api.AuthResult{
+		Provider:    provider,
+		Subject:     info.Subject,
+		DisplayName: info.DisplayName,
+	}
+	if memberID != "" {
+		res.MemberId = &memberID
+	}
+	if info.Email != "" {
+		email := openapi_types.Email(info.Email)
+		res.Email = &email
+	}
+	if info.PictureURL != "" {
+		res.PictureUrl = &info.PictureURL
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// recordLogin は最終ログイン日時を残す。LINE の場合は検証済みメールアドレスと accounts.line もメンバーに反映する。
+func (h *Handler) recordLogin(ctx context.Context, provider string, info *identity.UserInfo, memberID string) error {
+	if err := h.identitiesSvc.RecordLogin(ctx, provider, info.Subject, info.Email); err != nil {
+		return err
+	}
+	if provider == identity.ProviderLINE {
+		return h.membersSvc.RecordLineLogin(ctx, memberID, info.Email)
+	}
+	return nil
+}
+
+// isSuspended はメンバーが利用停止中（または削除済み）かを返す。
+func (h *Handler) isSuspended(ctx context.Context, memberID string) (bool, error) {
+	m, err := h.membersSvc.Get(ctx, memberID)
+	if err != nil {
+		return false, err
+	}
+	return m.EffectiveStatus() == service.StatusSuspended || m.Deleted(), nil
+}
+
+// respondSuspended は利用停止中のメンバーのログインを断る。err があればその取得エラーを返す。
+func respondSuspended(c *gin.Context, err error) {
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusForbidden, gin.H{"error": "this account is suspended"})
+}
+
+// respondIdentityError はプロバイダ呼び出しのエラーをレスポンスに変換する。
+func respondIdentityError(c *gin.Context, msg string, err error) {
+	logging.FromContext(c.Request.Context()).Warn(msg, "error", err)
+
+	var apiErr *line.APIError
+	switch {
+	case errors.Is(err, identity.ErrInvalidGrant):
+		// 認可コードが無効・期限切れ・使用済み：ログインをやり直してもらう
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid or expired authorization code"})
+	case errors.Is(err, identity.ErrInvalidToken):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id_token"})
+	case errors.Is(err, identity.ErrNotConfigured),
+		errors.As(err, &apiErr) && !apiErr.ServerError():
+		// 設定不足や invalid_client など、こちらの設定やリクエストの問題
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	default:
+		// プロバイダ側の障害・通信エラー
+		c.JSON(http.StatusBadGateway, gin.H{"error": msg})
+	}
+}
+
+// authCookiePath は state / nonce の Cookie のパス。LINE は従来のコールバック /api/line-oauth にも送られるよう /api にする。
+func authCookiePath(provider string) string {
+	if provider == identity.ProviderLINE {
+		return "/api"
+	}
+	return "/api/auth/" + provider
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/identity"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeProvider は認可コード "good" だけを受け付ける ID プロバイダ。
+type fakeProvider struct {
+	user identity.UserInfo
+}
+
+func (p *fakeProvider) Configured() bool { return true }
+
+func (p *fakeProvider) AuthorizeURL(state, nonce string) string {
+	return "https://idp.example.com/authorize?state=" + state
+}
+
+func (p *fakeProvider) Exchange(ctx context.Context, code string) (*identity.Token, error) {
+	if code != "good" {
+		return nil, identity.ErrInvalidGrant
+	}
+	return &identity.Token{AccessToken: "at"}, nil
+}
+
+func (p *fakeProvider) UserInfo(ctx context.Context, token *identity.Token, nonce string) (*identity.UserInfo, error) {
+	return &p.user, nil
+}
+
+// newAuthCallbackRequest は state / nonce Cookie と（あれば）セッション Cookie 付きのコールバックリクエストを作る。
+func newAuthCallbackRequest(t *testing.T, h *Handler, provider, code, memberID string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/auth/"+provider+"/callback?code="+code+"&state=s1", nil)
+	req.AddCookie(&http.Cookie{Name: authStateCookie, Value: "s1"})
+	req.AddCookie(&http.Cookie{Name: authNonceCookie, Value: "n1"})
+	if memberID != "" {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if err := h.issueSession(c, memberID); err != nil {
+			t.Fatal(err)
+		}
+		for _, ck := range w.Result().Cookies() {
+			req.AddCookie(ck)
+		}
+	}
+	return req
+}
+
+func TestGetApiAuthProviderCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := NewHandler(nil, newTestLINEConfig(""), Services{})
+	h.providers["example"] = &fakeProvider{user: identity.UserInfo{Subject: "g-1", Email: "taro@example.com", DisplayName: "Taro"}}
+	h.membersSvc = newFakeMembers(service.Member{Id: "m1"})
+	identities := newFakeIdentities()
+	h.identitiesSvc = identities
+	router := newLinkRouter(h)
+
+	call := func(t *testing.T, provider, code, memberID string) (*httptest.ResponseRecorder, api.AuthResult) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newAuthCallbackRequest(t, h, provider, code, memberID))
+		var got api.AuthResult
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w, got
+	}
+	sessionOf := func(w *httptest.ResponseRecorder) string {
+		for _, ck := range w.Result().Cookies() {
+			if ck.Name == AuthCookieName {
+				return ck.Value
+			}
+		}
+		return ""
+	}
+
+	t.Run("unknown provider", func(t *testing.T) {
+		if w, _ := call(t, "nope", "good", ""); w.Code != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", w.Code)
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		if w, _ := call(t, "example", "bad", ""); w.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("unregistered user gets no session", func(t *testing.T) {
+		w, got := call(t, "example", "good", "")
+		if w.Code != http.StatusOK || got.MemberId != nil || sessionOf(w) != "" {
+			t.Fatalf("unexpected result: %d %+v", w.Code, got)
+		}
+		if got.Subject != "g-1" || got.DisplayName != "Taro" {
+			t.Fatalf("unexpected user: %+v", got)
+		}
+		// 招待コードで登録できるよう登録用トークンが渡される
+		if provider, subject, ok := h.onboardingIdentity(requestWithCookies(w)); !ok || provider != "example" || subject != "g-1" {
+			t.Fatalf("unexpected onboarding identity: %q %q %v", provider, subject, ok)
+		}
+	})
+
+	t.Run("logged-in member adds the identity", func(t *testing.T) {
+		w, got := call(t, "example", "good", "m1")
+		if w.Code != http.StatusOK || got.MemberId == nil || *got.MemberId != "m1" {
+			t.Fatalf("unexpected result: %d %+v", w.Code, got)
+		}
+		if _, err := identities.Resolve(context.Background(), "example", "g-1"); err != nil {
+			t.Fatalf("identity was not linked: %v", err)
+		}
+	})
+
+	t.Run("linked identity logs in", func(t *testing.T) {
+		w, got := call(t, "example", "good", "")
+		if w.Code != http.StatusOK || got.MemberId == nil || *got.MemberId != "m1" {
+			t.Fatalf("unexpected result: %d %+v", w.Code, got)
+		}
+		claims, err := h.sessions.AuthenticateJWT(sessionOf(w))
+		if err != nil || claims.UserID != "m1" {
+			t.Fatalf("unexpected session: %v %v", claims, err)
+		}
+	})
+}
+
+// requestWithCookies はレスポンスでセットされた Cookie を付けたリクエストの gin.Context を返す。
+func requestWithCookies(w *httptest.ResponseRecorder) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, ck := range w.Result().Cookies() {
+		c.Request.AddCookie(ck)
+	}
+	return c
+}
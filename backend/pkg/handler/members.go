@@ -1,97 +1,74 @@
 package handler
 
 import (
-	"log/slog"
+	"errors"
 	"net/http"
+	"time"
 
 	api "github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
 	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
 	"github.com/gin-gonic/gin"
-	"google.golang.org/api/iterator"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // 機能：Firestore の "members" コレクションから全ドキュメントを読み込み、api.MemberSummary の配列として返す。
-func (h *Handler) GetApiMembers(c *gin.Context) {
-	// --- ① Firestore へ問い合わせるための Context と iterator を用意 ---
+// status で在籍中・卒業生などに、tag でスキル・興味タグに絞り込める。
+func (h *Handler) GetApiMembers(c *gin.Context, params api.GetApiMembersParams) {
+	// --- ① Firestore へ問い合わせるための Context を用意 ---
 	ctx := c.Request.Context()
-	iter := h.fs.Collection("members").Documents(ctx)
-	defer iter.Stop()
-
-	// --- ② レスポンス用の配列を用意 ---
-	out := make([]api.MemberSummary, 0)
 
-	// --- ③ Firestore の全ドキュメントを順に読むループ ---
-	for {
-		doc, err := iter.Next()
+	// --- ② Service層から全メンバーを取得 ---
+	// 壊れたドキュメントは Service 側で Warn ログを残して除外済み
+	// Firestore から取れない（通信/権限/一時障害など）場合は API 全体として失敗扱い（500）にする
+	members, err := h.membersSvc.List(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
-		// --- ③-1 終了条件：最後まで読み終えた ---
-		if err == iterator.Done {
-			break
+	// --- ③ 絞り込み条件を確認 ---
+	// 承認待ち・利用停止のメンバーは役員にだけ見せる
+	var want string
+	if params.Status != nil {
+		want = string(*params.Status)
+		if !service.ValidStatus(want) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, active, alumni or suspended"})
+			return
 		}
-
-		// --- ③-2 例外：Firestore から取れない（通信/権限/一時障害など） ---
-		// このケースは API 全体として失敗扱い（500）にして返す
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if !officer && want != service.StatusActive && want != service.StatusAlumni {
+			c.JSON(http.StatusForbidden, gin.H{"error": "officers only"})
 			return
 		}
+	}
+	// タグは保存時と同じ表記にそろえてから比べる
+	var tags []string
+	if params.Tag != nil {
+		for _, t := range *params.Tag {
+			tags = append(tags, service.NormalizeTag(t))
+		}
+	}
 
-		// --- ④ ドキュメントを struct(api.MemberDetail) にデコード（型変換） ---
-		// Firestoreのデータ型が struct と一致していれば成功する
-		// 例）roles が []string で入っている、name が string で入っている、など
-		var d api.MemberDetail
-		if err := doc.DataTo(&d); err == nil {
-			// --- ④-1 デコード成功：普通に MemberSummary に整形して out に追加 ---
-			// d.Id が空なら docID で補う
-			if d.Id == "" {
-				d.Id = doc.Ref.ID
-			}
-			out = append(out, api.MemberSummary{
-				Id:       d.Id,
-				Name:     d.Name,
-				Nickname: d.Nickname,
-				Roles:    d.Roles,
-				Avatar:   d.Avatar,
-			})
+	// --- ④ レスポンス用の配列に整形 ---
+	// 指定がなければ、役員以外には在籍中のメンバーだけを返す
+	out := make([]api.MemberSummary, 0, len(members))
+	for _, m := range members {
+		switch st := m.EffectiveStatus(); {
+		case want != "" && st != want:
+			continue
+		case want == "" && !officer && st != service.StatusActive:
+			continue
+		case !m.HasTags(tags):
 			continue
 		}
+		out = append(out, m.ToSummary())
+	}
 
-		// --- ⑤ デコード失敗時の扱い（ここが今回の方針変更点） ---
-		// AFTER（現在の方針）：
-		//   ・データ型が壊れている/想定外なら "壊れたドキュメント" とみなす
-		//   ・Warnログを残して、その1件は一覧から除外（skip）する
-		//   ・一覧API自体は落とさない（残りの正常データは返す）
-		//
-		// BEFORE（元の方針）は下のコメントアウト参照：
-		//   ・doc.Data() の map を直接読んで
-		//   ・asString / asStringSlice で無理やり値を拾って一覧に混ぜて返していた
-		//   ・ただし不整合が「空文字/空配列」になって気づきにくい副作用があった
-
-		// BEFORE (元コードの fallback：参考として残す)
-		// slog.Warn("failed to parse document into MemberDetail, will fallback to raw map", "doc", doc.Ref.ID, "error", err)
-		//
-		// // fallback: minimal summary from raw map (map[string]any を直接読む)
-		// m := doc.Data()
-		// // asString/asStringSlice で型を"それっぽく"合わせて MemberSummary を作る
-		// s := api.MemberSummary{
-		// 	Id:       doc.Ref.ID,
-		// 	Name:     asString(m["name"]),
-		// 	Nickname: asString(m["nickname"]),
-		// 	Roles:    asStringSlice(m["roles"]),
-		// }
-		// if avatar := asString(m["avatar"]); avatar != "" {
-		// 	s.Avatar = &avatar
-		// }
-		// out = append(out, s)
-
-		// AFTER（現在：壊れたdocは skip）
-		slog.Warn("failed to parse document into MemberDetail, skip", "doc", doc.Ref.ID, "error", err)
-		continue
-	}
-
-	// --- ⑥ ループ完了：正常に取れた分だけ返す ---
+	// --- ⑤ 正常に取れた分だけ返す ---
 	c.JSON(http.StatusOK, out)
 }
 
@@ -100,45 +77,58 @@ func (h *Handler) GetApiMembersId(c *gin.Context, id string) {
 	// --- ① Firestore へ問い合わせるための Context を用意 ---
 	ctx := c.Request.Context()
 
-	// --- ② 指定IDのドキュメントを1件取得 ---
-	// members/{id} を取得する（存在しない/権限がない/通信エラーなどがありうる）
-	docSnap, err := h.fs.Collection("members").Doc(id).Get(ctx)
+	// --- ② 指定IDのメンバーを1件取得 ---
+	m, err := h.membersSvc.Get(ctx, id)
 	if err != nil {
 		// --- ②-1 そのIDのドキュメントが存在しない場合 ---
 		// 「サーバの故障」ではなく「指定されたリソースがない」ので 404 を返す
-		if status.Code(err) == codes.NotFound {
+		if errors.Is(err, service.ErrMemberNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
 			return
 		}
 
 		// --- ②-2 それ以外の取得エラー ---
-		// 例：Firestoreへの通信失敗、権限不足、一時障害など
+		// 例：Firestoreへの通信失敗、権限不足、一時障害、デコード失敗など
 		// この場合は APIとして処理を完了できないので 500 を返す
 		// （本番運用では err.Error() を返さず、ログに詳細を出す形にすることが多い）
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	// --- ③ 取得したドキュメントを struct(api.MemberDetail) にデコード（型変換） ---
-	// Firestore上のデータ型が struct と一致しないと失敗する
-	// 例：roles が []string ではなく string だった、name が number だった、など
-	var d api.MemberDetail
-	if err := docSnap.DataTo(&d); err != nil {
-		// --- ③-1 デコード失敗 ---
-		// 詳細取得APIは「その1件が返せない」= API失敗なので、ログをErrorで残して500
-		slog.Error("failed to parse member document", "doc", docSnap.Ref.ID, "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// --- ②-3 別のメンバーに統合済みなら統合先へ転送する（301） ---
+	if m.MergedInto != "" {
+		c.Redirect(http.StatusMovedPermanently, "/api/members/"+m.MergedInto)
 		return
 	}
 
-	// --- ④ レスポンスに必要な補完（IDが空ならdocIDを入れる） ---
-	// Firestoreのフィールドに id が入っていない/空でも、docのIDを返すようにする
-	if d.Id == "" {
-		d.Id = docSnap.Ref.ID
+	// --- ③ 承認待ち・利用停止のメンバーは役員と本人以外に、削除済みのメンバーは役員以外に存在しない扱いにする ---
+	if !m.PubliclyVisible() && (currentMemberID(c) != m.Id || m.Deleted()) {
+		officer, err := h.viewerIsOfficer(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !officer {
+			c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+			return
+		}
 	}
 
-	// --- ⑤ 正常終了：MemberDetail を返す（200） ---
-	c.JSON(http.StatusOK, d)
+	// --- ④ これまでの役職の任期を載せる ---
+	detail := m.ToDetail()
+	history, err := h.roleHistory(ctx, m.Id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	detail.RoleHistory = &history
+
+	// --- ⑤ SNS アカウントは本人以外には公開設定が true のものだけ載せる ---
+	social := m.SocialLinks(currentMemberID(c) == m.Id)
+	detail.Social = &social
+
+	// --- ⑥ 正常終了：MemberDetail を返す（200） ---
+	c.JSON(http.StatusOK, detail)
 }
 
 ////////////////////////////////////////////////////
@@ -174,6 +164,7 @@ func (h *Handler) GetApiMembersId(c *gin.Context, id string) {
 // 	}
 
 // PostApiMembers は新しいメンバーを登録する。
+// 役員はそのまま登録できる。それ以外は外部 ID でログイン済み（未登録）のユーザーが、招待コードを使って自分を登録する。
 func (h *Handler) PostApiMembers(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -184,24 +175,113 @@ func (h *Handler) PostApiMembers(c *gin.Context) {
 		return
 	}
 
+	if err := validateEnrollment(req.EnrollmentYear, req.ProgramYears, time.Now()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.StudentId != nil && *req.StudentId != "" && !service.ValidStudentID(service.NormalizeStudentID(*req.StudentId)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid student_id"})
+		return
+	}
+
 	// --- ② api.MemberCreate を service.Member に変換 ---
+	// 役職は登録済みの班・役職に揃える（「web班」は「Web班」になる）
 	member := service.MemberFromAPICreate(req)
+	if req.Tags != nil {
+		tags, err := service.NormalizeTags(*req.Tags)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		member.Tags = tags
+	}
+	teams, ok := h.resolveTeams(c, req.Roles)
+	if !ok {
+		return
+	}
+	member.SetTeams(teams)
+
+	// --- ③ 役員による登録か、招待コードによる本人の登録かを判定 ---
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !officer {
+		h.registerWithInvitation(c, req, member)
+		return
+	}
+	// 役員が登録したメンバーは承認不要
+	member.Status = service.StatusActive
 
-	// --- ③ Service層に登録を依頼 ---
+	// --- ④ Service層に登録を依頼 ---
 	id, err := h.membersSvc.Register(ctx, member)
+	if errors.Is(err, service.ErrStudentIDTaken) || errors.Is(err, service.ErrLineUserIDTaken) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
-		slog.Error("failed to register member", "error", err)
+		logging.FromContext(ctx).Error("failed to register member", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	// --- ④ 成功レスポンス ---
+	h.searchIndex.Invalidate()
+
+	// --- ⑤ 成功レスポンス ---
 	c.JSON(http.StatusCreated, api.MemberCreateResponse{
 		Id:      id,
 		Message: "メンバーを登録しました",
 	})
 }
 
+// registerWithInvitation は招待コードを消費して本人をメンバー登録し、そのままログインさせる。
+func (h *Handler) registerWithInvitation(c *gin.Context, req api.MemberCreate, member service.Member) {
+	ctx := c.Request.Context()
+
+	provider, subject, ok := h.onboardingIdentity(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "login with LINE before registering"})
+		return
+	}
+	if req.InvitationCode == nil || *req.InvitationCode == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invitation_code is required"})
+		return
+	}
+
+	id, err := h.invitationsSvc.Redeem(ctx, *req.InvitationCode, member, provider, subject)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrInvitationInvalid):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, service.ErrIdentityAlreadyLinked):
+		c.JSON(http.StatusConflict, gin.H{"error": "already registered"})
+		return
+	case errors.Is(err, service.ErrStudentIDTaken), errors.Is(err, service.ErrLineUserIDTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	default:
+		logging.FromContext(ctx).Error("failed to register member with invitation", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logging.FromContext(ctx).Info("member registered with invitation", "member", id, "provider", provider)
+	h.searchIndex.Invalidate()
+
+	// 登録用トークンは使い終わったので消し、メンバーとしてログインさせる
+	c.SetCookie(onboardingCookie, "", -1, "/api", "", false, true)
+	if err := h.issueSession(c, id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.MemberCreateResponse{
+		Id:      id,
+		Message: "登録を受け付けました。役員の承認後にメンバー一覧に表示されます",
+	})
+}
+
 // 	if a, ok := v.([]any); ok {
 // 		out := make([]string, 0, len(a))
 // 		for _, x := range a {
@@ -216,3 +296,14 @@ func (h *Handler) PostApiMembers(c *gin.Context) {
 // 	}
 // 	return []string{}
 // }
+
+// validateEnrollment は入学年度（2000 年度から来年度まで）と修業年限（1〜9 年）の範囲を確かめる。未指定はそのまま通す。
+func validateEnrollment(enrollmentYear, programYears *int, now time.Time) error {
+	if enrollmentYear != nil && (*enrollmentYear < 2000 || *enrollmentYear > service.AcademicYear(now)+1) {
+		return errors.New("enrollment_year is out of range")
+	}
+	if programYears != nil && (*programYears < 1 || *programYears > 9) {
+		return errors.New("program_years must be between 1 and 9")
+	}
+	return nil
+}
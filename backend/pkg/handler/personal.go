@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/export"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetApiMeExport はログイン中のメンバーについて保存しているデータを、JSON ファイルの ZIP で返す。
+func (h *Handler) GetApiMeExport(c *gin.Context) {
+	ctx := c.Request.Context()
+	memberID := currentMemberID(c)
+
+	// --- ① 保存しているデータを集める ---
+	data, err := h.membersSvc.PersonalData(ctx, memberID)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to collect personal data", "member", memberID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// --- ② プロフィールは本人が詳細を見たときと同じ形にする ---
+	now := time.Now()
+	profile := data.Member.ToDetail()
+	history := make([]api.RoleTerm, 0, len(data.Terms))
+	for _, t := range data.Terms {
+		history = append(history, t.ToAPI(now))
+	}
+	profile.RoleHistory = &history
+	social := data.Member.SocialLinks(true)
+	profile.Social = &social
+	identities := data.Identities
+	if identities == nil {
+		identities = []service.Identity{}
+	}
+
+	// --- ③ ZIP で返す ---
+	files := []export.ArchiveFile{
+		{Name: "profile.json", Data: profile},
+		{Name: "member.json", Data: data.Record},
+		{Name: "identities.json", Data: identities},
+		{Name: "invitations.json", Data: data.InvitationRecords()},
+		{Name: "audit.json", Data: data.AuditLog()},
+	}
+	logging.FromContext(ctx).Info("personal data exported", "member", memberID)
+	c.Header("Content-Type", export.ArchiveContentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="my-data-%s.zip"`, now.Format("20060102")))
+	c.Status(http.StatusOK)
+	if err := export.WriteArchive(c.Writer, files, now); err != nil {
+		// ヘッダーは送ってしまっているのでログだけ残す
+		logging.FromContext(ctx).Error("failed to write personal data archive", "member", memberID, "error", err)
+	}
+}
+
+// DeleteApiMe はログイン中のメンバーを退会させ、データを削除してセッションを無効にする。
+func (h *Handler) DeleteApiMe(c *gin.Context) {
+	ctx := c.Request.Context()
+	memberID := currentMemberID(c)
+
+	err := h.membersSvc.Erase(ctx, memberID)
+	if err != nil && !errors.Is(err, service.ErrMemberNotFound) {
+		logging.FromContext(ctx).Error("failed to erase member", "member", memberID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	// メンバーがいなくなるので、他の端末のセッションも RequireAuth で弾かれる
+	logging.FromContext(ctx).Info("member erased", "member", memberID)
+	h.searchIndex.Invalidate()
+	c.SetCookie(AuthCookieName, "", -1, "/", "", false, true)
+	c.Status(http.StatusNoContent)
+}
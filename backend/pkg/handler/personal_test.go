@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetApiMeExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-regular"].Email = "regular@example.com"
+	router := newLinkRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/me/export", "", ""))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous: expected 401, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/me/export", "", "m-regular"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	for _, name := range []string{"profile.json", "member.json", "identities.json", "invitations.json", "audit.json"} {
+		if files[name] == nil {
+			t.Fatalf("%s is missing: %v", name, files)
+		}
+	}
+	if files["events.json"] != nil {
+		t.Fatal("events.json duplicates profile.json and should not be included")
+	}
+	// メールアドレスのような API で返さない項目も本人には渡す
+	f, err := files["member.json"].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var record map[string]any
+	if err := json.NewDecoder(f).Decode(&record); err != nil {
+		t.Fatal(err)
+	}
+	if record["email"] != "regular@example.com" {
+		t.Fatalf("member record should include the email: %v", record)
+	}
+}
+
+func TestDeleteApiMe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	// 退会前に発行した別の端末のセッション
+	other := newMemberRequest(t, h, http.MethodGet, "/api/me/social", "", "m-regular")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodDelete, "/api/me", "", "m-regular"))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", w.Code, w.Body.String())
+	}
+	if _, ok := members.members["m-regular"]; ok {
+		t.Fatal("member should be erased")
+	}
+	if c := w.Result().Cookies(); len(c) == 0 || c[0].Name != AuthCookieName || c[0].MaxAge >= 0 {
+		t.Fatalf("session cookie should be cleared: %+v", c)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, other)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("old session: expected 401, got %d", w.Code)
+	}
+}
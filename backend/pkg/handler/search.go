@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// GetApiMembersSearch は名前・ニックネーム・役職・自己紹介からメンバーを検索する。
+// 承認待ち・利用停止のメンバーは役員にだけ返す。
+func (h *Handler) GetApiMembersSearch(c *gin.Context, params api.GetApiMembersSearchParams) {
+	ctx := c.Request.Context()
+
+	// --- ① 検索条件を確認 ---
+	q := strings.TrimSpace(params.Q)
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is required"})
+		return
+	}
+	limit := defaultSearchLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxSearchLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+		return
+	}
+
+	// --- ② 索引が古ければ作り直してから検索 ---
+	if err := h.refreshSearchIndex(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	hits := h.searchIndex.Search(q, limit, func(m *service.Member) bool {
+		return officer || m.PubliclyVisible()
+	})
+
+	// --- ③ レスポンスに整形 ---
+	out := make([]api.MemberSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		fields := make([]api.MemberSearchHitMatchedFields, 0, len(hit.Fields))
+		for _, f := range hit.Fields {
+			fields = append(fields, api.MemberSearchHitMatchedFields(f))
+		}
+		out = append(out, api.MemberSearchHit{
+			Member:        hit.Member.ToSummary(),
+			Score:         float32(hit.Score),
+			MatchedFields: fields,
+		})
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// refreshSearchIndex は検索用の索引が古くなっていれば、メンバー一覧から作り直す。
+func (h *Handler) refreshSearchIndex(ctx context.Context) error {
+	now := time.Now()
+	if !h.searchIndex.NeedsRebuild(now, h.searchIndexTTL) {
+		return nil
+	}
+	members, err := h.membersSvc.List(ctx)
+	if err != nil {
+		return err
+	}
+	h.searchIndex.Rebuild(members, now)
+	return nil
+}
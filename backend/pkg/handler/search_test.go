@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetApiMembersSearch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-regular"].Name = "田中 太郎"
+	members.members["m-regular"].Nickname = "タナタロ"
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Name: "田中 次郎", Status: service.StatusPending}
+	// 時間では作り直さず、登録による作り直しだけを確かめる
+	h.searchIndexTTL = time.Hour
+	router := newLinkRouter(h)
+
+	search := func(query, member string) (int, []api.MemberSearchHit) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/members/search?"+query, "", member))
+		var got []api.MemberSearchHit
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code, got
+	}
+
+	// ローマ字でカタカナのニックネームに一致する
+	code, got := search("q=tanataro", "")
+	if code != http.StatusOK || len(got) != 1 || got[0].Member.Id != "m-regular" || got[0].MatchedFields[0] != "nickname" {
+		t.Fatalf("unexpected result: %d %+v", code, got)
+	}
+
+	// 承認待ちのメンバーは役員にだけ見える
+	if _, got := search("q="+"%E7%94%B0%E4%B8%AD", ""); len(got) != 1 {
+		t.Fatalf("expected only the active member, got %+v", got)
+	}
+	if _, got := search("q="+"%E7%94%B0%E4%B8%AD", "m-officer"); len(got) != 2 {
+		t.Fatalf("officers should also find pending members, got %+v", got)
+	}
+
+	// 登録すると索引に反映される
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members",
+		`{"name":"佐藤 花子","nickname":"はなこ","roles":[],"accounts":{"line":false,"discord":false,"github":false}}`, "m-officer"))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", w.Code)
+	}
+	if _, got := search("q=hanako", ""); len(got) != 1 {
+		t.Fatalf("expected the new member to be searchable, got %+v", got)
+	}
+
+	for _, query := range []string{"q=", "q=%20", "q=a&limit=0", "q=a&limit=101"} {
+		if code, _ := search(query, ""); code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", query, code)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetApiMeSocial はログイン中のメンバーの SNS アカウントと公開設定を返す。
+func (h *Handler) GetApiMeSocial(c *gin.Context) {
+	m, err := h.membersSvc.Get(c.Request.Context(), currentMemberID(c))
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, api.SocialProfile{Handles: m.Social.ToAPI(), Visibility: m.Visibility.ToAPI()})
+}
+
+// PutApiMeSocial はログイン中のメンバーの SNS アカウントと公開設定を置き換える。
+// ユーザー名は「@name」や URL で入力されてもユーザー名の形にそろえて保存する。
+func (h *Handler) PutApiMeSocial(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.SocialProfile
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	handles, err := service.NormalizeSocialHandles(service.SocialHandlesFromAPI(req.Handles))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	vis := service.VisibilityFromAPI(req.Visibility)
+
+	err = h.membersSvc.UpdateSocial(ctx, currentMemberID(c), handles, vis)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to update social accounts", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, api.SocialProfile{Handles: handles.ToAPI(), Visibility: vis.ToAPI()})
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMemberSocial(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	do := func(method, path, body, member string, out any) int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, body, member))
+		if out != nil && w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code
+	}
+
+	// URL や @ 付きで入力してもユーザー名にそろえて保存する
+	body := `{"handles":{"x":"@lumos","github":"https://github.com/lumos-dev"},"visibility":{"name":true,"self_introduction":true,"x":true,"instagram":false}}`
+	var saved api.SocialProfile
+	if code := do(http.MethodPut, "/api/me/social", body, "m-regular", &saved); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if saved.Handles.X == nil || *saved.Handles.X != "lumos" || *saved.Handles.Github != "lumos-dev" {
+		t.Fatalf("unexpected handles: %+v", saved.Handles)
+	}
+	if members.members["m-regular"].Social.GitHub != "lumos-dev" || members.members["m-regular"].Visibility.GitHub {
+		t.Fatalf("unexpected stored member: %+v", members.members["m-regular"])
+	}
+	if code := do(http.MethodPut, "/api/me/social", `{"handles":{"x":"not a handle"},"visibility":{"name":true,"self_introduction":true,"x":true,"instagram":true}}`, "m-regular", nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid handle, got %d", code)
+	}
+	if code := do(http.MethodGet, "/api/me/social", "", "", nil); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without login, got %d", code)
+	}
+
+	// 他のメンバーには公開設定が true のものだけ、本人にはすべて見える
+	var detail api.MemberDetail
+	do(http.MethodGet, "/api/members/m-regular", "", "m-officer", &detail)
+	if detail.Social == nil || detail.Social.X == nil || detail.Social.X.Url != "https://x.com/lumos" || detail.Social.Github != nil {
+		t.Fatalf("unexpected social for others: %+v", detail.Social)
+	}
+	do(http.MethodGet, "/api/members/m-regular", "", "m-regular", &detail)
+	if detail.Social.Github == nil || detail.Social.Github.Url != "https://github.com/lumos-dev" {
+		t.Fatalf("the member should see their own github: %+v", detail.Social)
+	}
+}
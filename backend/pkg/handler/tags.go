@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSimilarLimit = 10
+	maxSimilarLimit     = 50
+)
+
+// GetApiTags はタグごとのメンバー数を多い順に返す。役員以外には承認待ち・利用停止のメンバーを数えない。
+func (h *Handler) GetApiTags(c *gin.Context) {
+	members, err := h.visibleMembers(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	counts := service.CountTags(members)
+	out := make([]api.TagCount, 0, len(counts))
+	for _, tc := range counts {
+		out = append(out, api.TagCount{Tag: tc.Tag, Count: tc.Count})
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// PutApiMeTags はログイン中のメンバーのスキル・興味タグを置き換える。
+func (h *Handler) PutApiMeTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.TagsUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tags, err := service.NormalizeTags(req.Tags)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = h.membersSvc.UpdateTags(ctx, currentMemberID(c), tags)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to update tags", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	h.searchIndex.Invalidate()
+	c.JSON(http.StatusOK, api.TagsUpdate{Tags: tags})
+}
+
+// GetApiMembersIdSimilar はタグの重なりから興味の近いメンバーを返す。
+func (h *Handler) GetApiMembersIdSimilar(c *gin.Context, id string, params api.GetApiMembersIdSimilarParams) {
+	limit := defaultSimilarLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxSimilarLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 50"})
+		return
+	}
+
+	members, err := h.visibleMembers(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	// 対象のメンバーが見えない（存在しない・承認待ちなど）なら 404。本人は自分を基準に探せる
+	idx := slices.IndexFunc(members, func(m service.Member) bool { return m.Id == id })
+	if idx < 0 && id == currentMemberID(c) {
+		if self, err := h.membersSvc.Get(c.Request.Context(), id); err == nil {
+			members, idx = append(members, *self), len(members)
+		}
+	}
+	if idx < 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	}
+
+	similar := service.FindSimilar(members[idx], members, limit)
+	out := make([]api.SimilarMember, 0, len(similar))
+	for _, s := range similar {
+		out = append(out, api.SimilarMember{
+			Member:     s.Member.ToSummary(),
+			Score:      float32(s.Score),
+			SharedTags: s.SharedTags,
+		})
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// visibleMembers は閲覧者に見せてよいメンバー（役員なら全員、それ以外は在籍中と卒業生）を返す。
+func (h *Handler) visibleMembers(c *gin.Context) ([]service.Member, error) {
+	members, err := h.membersSvc.List(c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil || officer {
+		return members, err
+	}
+	return slices.DeleteFunc(members, func(m service.Member) bool { return !m.PubliclyVisible() }), nil
+}
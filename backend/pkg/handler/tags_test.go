@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMemberTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-officer"].Tags = []string{"python", "go"}
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Tags: []string{"python", "機械学習"}, Status: service.StatusPending}
+	router := newLinkRouter(h)
+
+	do := func(method, path, body, member string, out any) int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, body, member))
+		if out != nil && w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code
+	}
+
+	// 自分のタグは正規化して保存される
+	var updated api.TagsUpdate
+	if code := do(http.MethodPut, "/api/me/tags", `{"tags":["ＰＹＴＨＯＮ","ML","py"]}`, "m-regular", &updated); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if len(updated.Tags) != 2 || updated.Tags[0] != "python" || updated.Tags[1] != "機械学習" {
+		t.Fatalf("unexpected tags: %v", updated.Tags)
+	}
+	if code := do(http.MethodPut, "/api/me/tags", `{"tags":[" "]}`, "m-regular", nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for empty tag, got %d", code)
+	}
+	if code := do(http.MethodPut, "/api/me/tags", `{"tags":["go"]}`, "", nil); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without login, got %d", code)
+	}
+
+	// 承認待ちのメンバーは役員以外には数えない
+	var counts []api.TagCount
+	do(http.MethodGet, "/api/tags", "", "", &counts)
+	if len(counts) != 3 || counts[0].Tag != "python" || counts[0].Count != 2 {
+		t.Fatalf("unexpected counts: %+v", counts)
+	}
+	do(http.MethodGet, "/api/tags", "", "m-officer", &counts)
+	if counts[0].Count != 3 {
+		t.Fatalf("officers should count every member: %+v", counts)
+	}
+
+	// 一覧をタグで絞り込む（表記ゆれはそろえてから比べる）
+	var list []api.MemberSummary
+	do(http.MethodGet, "/api/members?tag=Python&tag=golang", "", "", &list)
+	if len(list) != 1 || list[0].Id != "m-officer" {
+		t.Fatalf("unexpected filtered list: %+v", list)
+	}
+
+	// 興味の近いメンバー
+	var similar []api.SimilarMember
+	if code := do(http.MethodGet, "/api/members/m-regular/similar", "", "", &similar); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if len(similar) != 1 || similar[0].Member.Id != "m-officer" || similar[0].SharedTags[0] != "python" {
+		t.Fatalf("unexpected similar members: %+v", similar)
+	}
+	if code := do(http.MethodGet, "/api/members/m-pending/similar", "", "", nil); code != http.StatusNotFound {
+		t.Fatalf("expected 404 for a hidden member, got %d", code)
+	}
+	if code := do(http.MethodGet, "/api/members/m-pending/similar", "", "m-pending", nil); code != http.StatusOK {
+		t.Fatalf("members should find mentors for themselves while pending, got %d", code)
+	}
+	if code := do(http.MethodGet, "/api/members/m-regular/similar?limit=51", "", "", nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for limit, got %d", code)
+	}
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// maxTeamNameLength は班・役職名の上限（文字数）
+const maxTeamNameLength = 50
+
+// teamsService は Handler が使う班・役職の操作。
+type teamsService interface {
+	List(ctx context.Context) ([]service.Team, error)
+	Get(ctx context.Context, id string) (*service.Team, error)
+	Resolve(ctx context.Context, refs []string) ([]service.Team, error)
+	Create(ctx context.Context, t service.Team) (*service.Team, error)
+	Update(ctx context.Context, id string, t service.Team) (*service.Team, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// GetApiTeams は班・役職の一覧を表示順に返す。
+func (h *Handler) GetApiTeams(c *gin.Context, params api.GetApiTeamsParams) {
+	if params.Kind != nil && !service.ValidTeamKind(string(*params.Kind)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be team or officer"})
+		return
+	}
+
+	teams, err := h.teamsSvc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	out := make([]api.Team, 0, len(teams))
+	for _, t := range teams {
+		if params.Kind != nil && t.Kind != string(*params.Kind) {
+			continue
+		}
+		out = append(out, t.ToAPI())
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// PostApiTeams は班・役職を追加する（役員のみ）。
+func (h *Handler) PostApiTeams(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	in, ok := bindTeamInput(c)
+	if !ok {
+		return
+	}
+	created, err := h.teamsSvc.Create(ctx, in)
+	if err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	logging.FromContext(ctx).Info("team created", "team", created.Id, "name", created.Name, "by", currentMemberID(c))
+	c.JSON(http.StatusCreated, created.ToAPI())
+}
+
+// GetApiTeamsId は班・役職を 1 件返す。
+func (h *Handler) GetApiTeamsId(c *gin.Context, id string) {
+	t, err := h.teamsSvc.Get(c.Request.Context(), id)
+	if err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, t.ToAPI())
+}
+
+// PutApiTeamsId は班・役職の名前・種類・表示順を更新する（役員のみ）。
+func (h *Handler) PutApiTeamsId(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	in, ok := bindTeamInput(c)
+	if !ok {
+		return
+	}
+	// 役員は auth.officer_roles の名前で判定するので、その名前の役職は付け替えられないようにする
+	// （改名すると役員がいなくなり、別の班をその名前にすると所属メンバー全員が役員になる）
+	cur, err := h.teamsSvc.Get(ctx, id)
+	if err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	if in.Name != cur.Name && (slices.Contains(h.officerRoles, cur.Name) || slices.Contains(h.officerRoles, in.Name)) {
+		c.JSON(http.StatusConflict, gin.H{"error": "teams named in auth.officer_roles cannot be renamed"})
+		return
+	}
+	updated, err := h.teamsSvc.Update(ctx, id, in)
+	if err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	logging.FromContext(ctx).Info("team updated", "team", id, "name", updated.Name, "by", currentMemberID(c))
+	// 班の名前が変わるとメンバーの役職名も変わる
+	h.searchIndex.Invalidate()
+	c.JSON(http.StatusOK, updated.ToAPI())
+}
+
+// DeleteApiTeamsId は所属メンバーのいない班・役職を削除する（役員のみ）。
+func (h *Handler) DeleteApiTeamsId(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	if err := h.teamsSvc.Delete(ctx, id); err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	logging.FromContext(ctx).Info("team deleted", "team", id, "by", currentMemberID(c))
+	c.Status(http.StatusNoContent)
+}
+
+// GetApiTeamsIdMembers は班・役職に所属するメンバーを返す。役員以外には在籍中のメンバーだけを返す。
+func (h *Handler) GetApiTeamsIdMembers(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	if _, err := h.teamsSvc.Get(ctx, id); err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	members, err := h.membersSvc.ListByRole(ctx, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	out := make([]api.MemberSummary, 0, len(members))
+	for _, m := range members {
+		if !officer && m.EffectiveStatus() != service.StatusActive {
+			continue
+		}
+		out = append(out, m.ToSummary())
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// bindTeamInput はリクエストボディを service.Team に変換する。不正なら 400 を返して false。
+func bindTeamInput(c *gin.Context) (service.Team, bool) {
+	var req api.TeamInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return service.Team{}, false
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len([]rune(name)) > maxTeamNameLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name must be 1 to 50 characters"})
+		return service.Team{}, false
+	}
+	if !service.ValidTeamKind(string(req.Kind)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be team or officer"})
+		return service.Team{}, false
+	}
+	t := service.Team{Name: name, Kind: string(req.Kind)}
+	if req.Order != nil {
+		t.Order = *req.Order
+	}
+	return t, true
+}
+
+// resolveTeams はメンバーや招待コードに付ける班・役職（名前か ID）を登録済みのものに解決する。
+// 登録されていないものがあれば 400 を返して false。
+func (h *Handler) resolveTeams(c *gin.Context, refs []string) ([]service.Team, bool) {
+	teams, err := h.teamsSvc.Resolve(c.Request.Context(), refs)
+	switch {
+	case err == nil:
+		return teams, true
+	case errors.Is(err, service.ErrUnknownTeam):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return nil, false
+}
+
+// respondTeamError は班・役職の操作のエラーをレスポンスに変換する。
+func (h *Handler) respondTeamError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrTeamNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "team not found"})
+	case errors.Is(err, service.ErrTeamNameTaken), errors.Is(err, service.ErrTeamInUse):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		logging.FromContext(c.Request.Context()).Error("team operation failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeTeams は teamsService のフェイク。名前の重複確認と解決は本物と同じ関数を使う。
+type fakeTeams struct {
+	teams   map[string]*service.Team
+	members *fakeMembers
+	nextID  int
+}
+
+func newFakeTeams(members *fakeMembers, ts ...service.Team) *fakeTeams {
+	f := &fakeTeams{teams: map[string]*service.Team{}, members: members}
+	for _, t := range ts {
+		f.teams[t.Id] = &t
+	}
+	return f
+}
+
+func (f *fakeTeams) List(ctx context.Context) ([]service.Team, error) {
+	out := make([]service.Team, 0, len(f.teams))
+	for _, t := range f.teams {
+		out = append(out, *t)
+	}
+	service.SortTeams(out)
+	return out, nil
+}
+
+func (f *fakeTeams) Get(ctx context.Context, id string) (*service.Team, error) {
+	t, ok := f.teams[id]
+	if !ok {
+		return nil, service.ErrTeamNotFound
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (f *fakeTeams) Resolve(ctx context.Context, refs []string) ([]service.Team, error) {
+	teams, _ := f.List(ctx)
+	return service.ResolveTeams(teams, refs)
+}
+
+func (f *fakeTeams) nameTaken(name, id string) bool {
+	for _, t := range f.teams {
+		if t.Id != id && service.NormalizeTeamName(t.Name) == service.NormalizeTeamName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeTeams) Create(ctx context.Context, t service.Team) (*service.Team, error) {
+	if f.nameTaken(t.Name, "") {
+		return nil, service.ErrTeamNameTaken
+	}
+	f.nextID++
+	t.Id = "t" + strconv.Itoa(f.nextID)
+	f.teams[t.Id] = &t
+	return &t, nil
+}
+
+func (f *fakeTeams) Update(ctx context.Context, id string, t service.Team) (*service.Team, error) {
+	if _, ok := f.teams[id]; !ok {
+		return nil, service.ErrTeamNotFound
+	}
+	if f.nameTaken(t.Name, id) {
+		return nil, service.ErrTeamNameTaken
+	}
+	t.Id = id
+	f.teams[id] = &t
+	return &t, nil
+}
+
+func (f *fakeTeams) Delete(ctx context.Context, id string) error {
+	if _, ok := f.teams[id]; !ok {
+		return service.ErrTeamNotFound
+	}
+	if ms, _ := f.members.ListByRole(ctx, id); len(ms) > 0 {
+		return service.ErrTeamInUse
+	}
+	delete(f.teams, id)
+	return nil
+}
+
+func TestTeamsCRUD(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, _, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	do := func(method, path, body, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, body, member))
+		return w
+	}
+
+	// 追加は役員だけ
+	if w := do(http.MethodPost, "/api/teams", `{"name":"ゲーム班","kind":"team"}`, "m-regular"); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for regular member, got %d", w.Code)
+	}
+	w := do(http.MethodPost, "/api/teams", `{"name":" ゲーム班 ","kind":"team","order":5}`, "m-officer")
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	var created api.Team
+	if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "ゲーム班" || created.Order != 5 {
+		t.Fatalf("unexpected team: %+v", created)
+	}
+
+	// 全角・大文字小文字違いは同じ名前として扱う
+	if w := do(http.MethodPost, "/api/teams", `{"name":"ＷＥＢ班","kind":"team"}`, "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("expected 409 for duplicate name, got %d", w.Code)
+	}
+	if w := do(http.MethodPost, "/api/teams", `{"name":"会計","kind":"treasurer"}`, "m-officer"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown kind, got %d", w.Code)
+	}
+
+	// 一覧は表示順、kind で絞り込める
+	w = do(http.MethodGet, "/api/teams?kind=officer", "", "")
+	var officers []api.Team
+	if err := json.Unmarshal(w.Body.Bytes(), &officers); err != nil {
+		t.Fatal(err)
+	}
+	if len(officers) != 1 || officers[0].Name != "代表" {
+		t.Fatalf("expected only 代表, got %+v", officers)
+	}
+
+	if w := do(http.MethodPut, "/api/teams/"+created.Id, `{"name":"ゲーム制作班","kind":"team"}`, "m-officer"); w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// 役員の判定に使う役職は、その名前から・その名前へ変えられない（表示順などは変えられる）
+	if w := do(http.MethodPut, "/api/teams/leader", `{"name":"部長","kind":"officer"}`, "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("renaming an officer role: expected 409, got %d", w.Code)
+	}
+	if w := do(http.MethodPut, "/api/teams/web", `{"name":"代表","kind":"officer"}`, "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("renaming to an officer role: expected 409, got %d", w.Code)
+	}
+	if w := do(http.MethodPut, "/api/teams/leader", `{"name":"代表","kind":"officer","order":1}`, "m-officer"); w.Code != http.StatusOK {
+		t.Fatalf("updating an officer role without renaming: expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := do(http.MethodPut, "/api/teams/nope", `{"name":"部長","kind":"officer"}`, "m-officer"); w.Code != http.StatusNotFound {
+		t.Fatalf("unknown team: expected 404, got %d", w.Code)
+	}
+
+	// 所属メンバーがいる班は消せない
+	if w := do(http.MethodDelete, "/api/teams/web", "", "m-officer"); w.Code != http.StatusConflict {
+		t.Fatalf("expected 409 for team with members, got %d", w.Code)
+	}
+	if w := do(http.MethodDelete, "/api/teams/"+created.Id, "", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", w.Code)
+	}
+	if w := do(http.MethodGet, "/api/teams/"+created.Id, "", ""); w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", w.Code)
+	}
+}
+
+func TestGetApiTeamsIdMembers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-pending"] = &service.Member{Id: "m-pending", RoleIDs: []string{"web"}, Roles: []string{"Web班"}, Status: service.StatusPending}
+	router := newLinkRouter(h)
+
+	list := func(member string) []api.MemberSummary {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/teams/web/members", "", member))
+		var got []api.MemberSummary
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	if got := list(""); len(got) != 1 || got[0].Id != "m-regular" {
+		t.Fatalf("expected only m-regular, got %+v", got)
+	}
+	if got := list("m-officer"); len(got) != 2 {
+		t.Fatalf("officers should also see pending members, got %+v", got)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, "/api/teams/nope/members", "", ""))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown team, got %d", w.Code)
+	}
+}
+
+func TestPostApiMembers_ResolvesRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	router := newLinkRouter(h)
+
+	// 表記ゆれは登録済みの名前に揃え、ID も保存する
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members",
+		`{"name":"佐藤 花子","nickname":"はなこ","roles":["web班","Web班"],"accounts":{"line":false,"discord":false,"github":false}}`, "m-officer"))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	var res api.MemberCreateResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	m := members.members[res.Id]
+	if len(m.RoleIDs) != 1 || m.RoleIDs[0] != "web" || m.Roles[0] != "Web班" {
+		t.Fatalf("roles were not resolved: %v %v", m.RoleIDs, m.Roles)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newMemberRequest(t, h, http.MethodPost, "/api/members",
+		`{"name":"佐藤 花子","nickname":"はなこ","roles":["存在しない班"],"accounts":{"line":false,"discord":false,"github":false}}`, "m-officer"))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown role, got %d", w.Code)
+	}
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// termsService は Handler が使う役職の任期の操作。
+type termsService interface {
+	ListByMember(ctx context.Context, memberID string) ([]service.RoleTerm, error)
+	ListByRole(ctx context.Context, roleID string) ([]service.RoleTerm, error)
+	Create(ctx context.Context, t service.RoleTerm) (*service.RoleTerm, error)
+	Delete(ctx context.Context, memberID, termID string) error
+}
+
+// PostApiMembersIdTerms はメンバーの役職の任期を記録する（役員のみ）。
+func (h *Handler) PostApiMembersIdTerms(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	// --- ① リクエストボディをパースして期間を決める ---
+	var req api.RoleTermCreate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var start time.Time
+	var end *time.Time
+	switch {
+	case req.AcademicYear != nil && req.StartDate != nil:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "specify either academic_year or start_date"})
+		return
+	case req.AcademicYear != nil:
+		if *req.AcademicYear < 2000 || *req.AcademicYear > service.AcademicYear(time.Now())+1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "academic_year is out of range"})
+			return
+		}
+		first, last := service.AcademicYearSpan(*req.AcademicYear)
+		start, end = first, &last
+	case req.StartDate != nil:
+		start = service.DateInJST(req.StartDate.Time)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "academic_year or start_date is required"})
+		return
+	}
+	if req.EndDate != nil {
+		last := service.DateInJST(req.EndDate.Time)
+		end = &last
+	}
+	if end != nil && end.Before(start) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return
+	}
+
+	// --- ② メンバーと役職を確認 ---
+	m, err := h.membersSvc.Get(ctx, id)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	teams, ok := h.resolveTeams(c, []string{req.Role})
+	if !ok {
+		return
+	}
+
+	// --- ③ 記録 ---
+	term, err := h.termsSvc.Create(ctx, service.RoleTerm{
+		MemberID:   m.Id,
+		MemberName: m.Name,
+		RoleID:     teams[0].Id,
+		Role:       teams[0].Name,
+		StartDate:  start,
+		EndDate:    end,
+		CreatedBy:  currentMemberID(c),
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to record role term", "member", id, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logging.FromContext(ctx).Info("role term recorded", "member", id, "role", term.RoleID, "by", term.CreatedBy)
+	c.JSON(http.StatusCreated, term.ToAPI(time.Now()))
+}
+
+// DeleteApiMembersIdTermsTermId は誤って記録した任期を削除する（役員のみ）。
+func (h *Handler) DeleteApiMembersIdTermsTermId(c *gin.Context, id string, termId string) {
+	ctx := c.Request.Context()
+
+	err := h.termsSvc.Delete(ctx, id, termId)
+	switch {
+	case err == nil:
+		logging.FromContext(ctx).Info("role term deleted", "member", id, "term", termId, "by", currentMemberID(c))
+		c.Status(http.StatusNoContent)
+	case errors.Is(err, service.ErrTermNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "role term not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetApiTeamsIdHolders は役職の歴代就任者を新しい順に返す。
+// 承認待ち・利用停止のメンバーの任期は役員にだけ見せる（削除済みのメンバーは当時の名前で残す）。
+func (h *Handler) GetApiTeamsIdHolders(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	if _, err := h.teamsSvc.Get(ctx, id); err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	terms, err := h.termsSvc.ListByRole(ctx, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	hidden := map[string]bool{}
+	if !officer && len(terms) > 0 {
+		members, err := h.membersSvc.List(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		for _, m := range members {
+			hidden[m.Id] = !m.PubliclyVisible()
+		}
+		// 削除済みのメンバーは List に含まれないので別に取る
+		deleted, err := h.membersSvc.ListDeleted(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		for _, m := range deleted {
+			hidden[m.Id] = true
+		}
+	}
+
+	now := time.Now()
+	out := make([]api.RoleTerm, 0, len(terms))
+	for _, t := range terms {
+		if hidden[t.MemberID] {
+			continue
+		}
+		out = append(out, t.ToAPI(now))
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// roleHistory はメンバー詳細に載せる任期の一覧を返す。
+func (h *Handler) roleHistory(ctx context.Context, memberID string) ([]api.RoleTerm, error) {
+	terms, err := h.termsSvc.ListByMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	out := make([]api.RoleTerm, 0, len(terms))
+	for _, t := range terms {
+		out = append(out, t.ToAPI(now))
+	}
+	return out, nil
+}
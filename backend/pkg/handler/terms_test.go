@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeTerms は termsService のフェイク。
+type fakeTerms struct {
+	terms  map[string]*service.RoleTerm
+	nextID int
+}
+
+func newFakeTerms() *fakeTerms {
+	return &fakeTerms{terms: map[string]*service.RoleTerm{}}
+}
+
+func (f *fakeTerms) list(match func(t *service.RoleTerm) bool) []service.RoleTerm {
+	out := make([]service.RoleTerm, 0)
+	for _, t := range f.terms {
+		if match(t) {
+			out = append(out, *t)
+		}
+	}
+	service.SortTerms(out)
+	return out
+}
+
+func (f *fakeTerms) ListByMember(ctx context.Context, memberID string) ([]service.RoleTerm, error) {
+	return f.list(func(t *service.RoleTerm) bool { return t.MemberID == memberID }), nil
+}
+
+func (f *fakeTerms) ListByRole(ctx context.Context, roleID string) ([]service.RoleTerm, error) {
+	return f.list(func(t *service.RoleTerm) bool { return t.RoleID == roleID }), nil
+}
+
+func (f *fakeTerms) Create(ctx context.Context, t service.RoleTerm) (*service.RoleTerm, error) {
+	f.nextID++
+	t.Id = "term" + strconv.Itoa(f.nextID)
+	f.terms[t.Id] = &t
+	return &t, nil
+}
+
+func (f *fakeTerms) Delete(ctx context.Context, memberID, termID string) error {
+	t, ok := f.terms[termID]
+	if !ok || t.MemberID != memberID {
+		return service.ErrTermNotFound
+	}
+	delete(f.terms, termID)
+	return nil
+}
+
+func TestRoleTerms(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-regular"].Name = "田中 太郎"
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Name: "承認待ち", Status: service.StatusPending}
+	router := newLinkRouter(h)
+
+	do := func(method, path, body, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, method, path, body, member))
+		return w
+	}
+
+	// 年度で指定すると 4/1〜翌 3/31 になる
+	w := do(http.MethodPost, "/api/members/m-regular/terms", `{"role":"代表","academic_year":2023}`, "m-officer")
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	var past api.RoleTerm
+	if err := json.Unmarshal(w.Body.Bytes(), &past); err != nil {
+		t.Fatal(err)
+	}
+	if past.StartDate.String() != "2023-04-01" || past.EndDate == nil || past.EndDate.String() != "2024-03-31" || past.Current {
+		t.Fatalf("unexpected term: %+v", past)
+	}
+	if w := do(http.MethodPost, "/api/members/m-officer/terms", `{"role":"leader","start_date":"2024-04-01"}`, "m-officer"); w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := do(http.MethodPost, "/api/members/m-pending/terms", `{"role":"代表","academic_year":2022}`, "m-officer"); w.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// 入力ミスは 400、役員以外は 403
+	for _, body := range []string{
+		`{"role":"代表"}`,
+		`{"role":"代表","academic_year":2023,"start_date":"2023-04-01"}`,
+		`{"role":"代表","start_date":"2024-04-01","end_date":"2024-03-31"}`,
+		`{"role":"会計","academic_year":2023}`,
+	} {
+		if w := do(http.MethodPost, "/api/members/m-regular/terms", body, "m-officer"); w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", body, w.Code)
+		}
+	}
+	if w := do(http.MethodPost, "/api/members/m-regular/terms", `{"role":"代表","academic_year":2023}`, "m-regular"); w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", w.Code)
+	}
+
+	// 歴代就任者は新しい順。承認待ちのメンバーの任期は役員にだけ見える
+	holders := func(member string) []api.RoleTerm {
+		var got []api.RoleTerm
+		if err := json.Unmarshal(do(http.MethodGet, "/api/teams/leader/holders", "", member).Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	got := holders("")
+	if len(got) != 2 || got[0].MemberId != "m-officer" || !got[0].Current || got[1].MemberName != "田中 太郎" {
+		t.Fatalf("unexpected holders: %+v", got)
+	}
+	if got := holders("m-officer"); len(got) != 3 {
+		t.Fatalf("officers should see every holder, got %+v", got)
+	}
+	// 削除済みのメンバーの任期も役員にだけ見える
+	members.members["m-regular"].DeletedAt = time.Now()
+	if got := holders(""); len(got) != 1 || got[0].MemberId != "m-officer" {
+		t.Fatalf("deleted holder should be hidden, got %+v", got)
+	}
+	if got := holders("m-officer"); len(got) != 3 {
+		t.Fatalf("officers should see deleted holders, got %+v", got)
+	}
+	members.members["m-regular"].DeletedAt = time.Time{}
+
+	// メンバー詳細に過去の役職が載る
+	var detail api.MemberDetail
+	if err := json.Unmarshal(do(http.MethodGet, "/api/members/m-regular", "", "").Body.Bytes(), &detail); err != nil {
+		t.Fatal(err)
+	}
+	if detail.RoleHistory == nil || len(*detail.RoleHistory) != 1 || (*detail.RoleHistory)[0].Role != "代表" {
+		t.Fatalf("unexpected role history: %+v", detail.RoleHistory)
+	}
+
+	if w := do(http.MethodDelete, "/api/members/m-officer/terms/"+past.Id, "", "m-officer"); w.Code != http.StatusNotFound {
+		t.Fatalf("deleting another member's term: expected 404, got %d", w.Code)
+	}
+	if w := do(http.MethodDelete, "/api/members/m-regular/terms/"+past.Id, "", "m-officer"); w.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", w.Code)
+	}
+}
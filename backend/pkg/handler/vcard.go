@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/vcard"
+	"github.com/gin-gonic/gin"
+)
+
+// GetApiMembersIdVcard はメンバーの連絡先を vCard で返す。見えるメンバーの範囲は GetApiMembersId と同じ。
+func (h *Handler) GetApiMembersIdVcard(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	m, err := h.membersSvc.Get(ctx, id)
+	if errors.Is(err, service.ErrMemberNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if m.MergedInto != "" {
+		c.Redirect(http.StatusMovedPermanently, "/api/members/"+m.MergedInto+"/vcard")
+		return
+	}
+	self := currentMemberID(c) == m.Id
+	if !m.PubliclyVisible() && (!self || m.Deleted()) {
+		officer, err := h.viewerIsOfficer(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !officer {
+			c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+			return
+		}
+	}
+
+	h.writeVCards(c, m.Id+".vcf", vcard.FromMember(m, self))
+}
+
+// GetApiTeamsIdVcard は班・役職に所属するメンバーの連絡先を 1 つの .vcf にまとめて返す。
+// 対象は GetApiTeamsIdMembers と同じ（役員以外には在籍中のメンバーだけ）。
+func (h *Handler) GetApiTeamsIdVcard(c *gin.Context, id string) {
+	ctx := c.Request.Context()
+
+	if _, err := h.teamsSvc.Get(ctx, id); err != nil {
+		h.respondTeamError(c, err)
+		return
+	}
+	members, err := h.membersSvc.ListByRole(ctx, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	officer, err := h.viewerIsOfficer(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	slices.SortStableFunc(members, func(a, b service.Member) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Id, b.Id))
+	})
+	viewer := currentMemberID(c)
+	cards := make([]vcard.Card, 0, len(members))
+	for i := range members {
+		m := &members[i]
+		if !officer && m.EffectiveStatus() != service.StatusActive {
+			continue
+		}
+		cards = append(cards, vcard.FromMember(m, viewer == m.Id))
+	}
+	h.writeVCards(c, "team-"+id+".vcf", cards...)
+}
+
+// writeVCards は cards を filename の添付ファイルとして返す。
+func (h *Handler) writeVCards(c *gin.Context, filename string, cards ...vcard.Card) {
+	c.Header("Content-Type", vcard.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+	if err := vcard.Write(c.Writer, cards...); err != nil {
+		logging.FromContext(c.Request.Context()).Error("failed to write vcard", "error", err)
+	}
+}
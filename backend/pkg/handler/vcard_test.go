@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func TestVCard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h, members, _ := newInvitationTestHandler(t)
+	members.members["m-regular"].Name = "田中 太郎"
+	members.members["m-regular"].Nickname = "たなたろ"
+	members.members["m-regular"].Visibility.Name = true
+	members.members["m-pending"] = &service.Member{Id: "m-pending", Name: "新入 生", RoleIDs: []string{"web"}, Roles: []string{"Web班"}, Status: service.StatusPending}
+	router := newLinkRouter(h)
+
+	get := func(path, member string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newMemberRequest(t, h, http.MethodGet, path, "", member))
+		return w
+	}
+
+	w := get("/api/members/m-regular/vcard", "")
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/vcard") {
+		t.Fatalf("unexpected response %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(w.Body.String(), "FN:田中 太郎\r\n") || !strings.Contains(w.Body.String(), "NICKNAME:たなたろ\r\n") {
+		t.Fatalf("unexpected vcard: %q", w.Body.String())
+	}
+	if w := get("/api/members/m-pending/vcard", ""); w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for a pending member, got %d", w.Code)
+	}
+	if w := get("/api/members/m-pending/vcard", "m-pending"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "FN:新入 生") {
+		t.Fatalf("members should get their own card, got %d", w.Code)
+	}
+
+	// 班のまとめは役員以外には在籍中のメンバーだけ
+	w = get("/api/teams/web/vcard", "")
+	if w.Code != http.StatusOK || strings.Count(w.Body.String(), "BEGIN:VCARD") != 1 {
+		t.Fatalf("unexpected team vcf (%d): %q", w.Code, w.Body.String())
+	}
+	if w := get("/api/teams/web/vcard", "m-officer"); strings.Count(w.Body.String(), "BEGIN:VCARD") != 2 {
+		t.Fatalf("officers should get every member: %q", w.Body.String())
+	}
+	if w := get("/api/teams/none/vcard", ""); w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for an unknown team, got %d", w.Code)
+	}
+}
@@ -0,0 +1,132 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/api/iterator"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check は依存先 1 つ分の健全性チェック。問題がなければ nil を返す。
+type Check func(ctx context.Context) error
+
+// Result は 1 つのチェック結果。
+type Result struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// Report は readiness のレスポンス。1 つでも失敗したら Status は fail になる。
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker は登録されたチェックをまとめて実行する。
+type Checker struct {
+	timeout time.Duration
+	checks  []namedCheck
+}
+
+// NewChecker は各チェックを timeout で打ち切る Checker を生成する。
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add はチェックを登録する。name はレスポンスの checks のキーになる。
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Run は全チェックを並行に実行して結果をまとめる。
+func (c *Checker) Run(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(c.checks))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func(nc namedCheck) {
+			defer wg.Done()
+			res := c.runOne(ctx, nc.check)
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[nc.name] = res
+			if res.Status != StatusOK {
+				report.Status = StatusFail
+			}
+		}(nc)
+	}
+	wg.Wait()
+	return report
+}
+
+func (c *Checker) runOne(ctx context.Context, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() { errCh <- check(ctx) }()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		// チェック自体が ctx を見ていなくても timeout で打ち切る
+		err = ctx.Err()
+	}
+
+	res := Result{Status: StatusOK, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// LivenessHandler はプロセスが応答できることだけを返す（/healthz）。依存先は見ない。
+func LivenessHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": StatusOK})
+}
+
+// ReadinessHandler は全チェックを実行し、失敗があれば 503 を返す（/readyz）。
+func (c *Checker) ReadinessHandler(ctx *gin.Context) {
+	report := c.Run(ctx.Request.Context())
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	ctx.JSON(code, report)
+}
+
+// FirestoreCheck は "members" コレクションを 1 件だけ読めるかで Firestore への疎通を確認する。
+func FirestoreCheck(client *firestore.Client) Check {
+	return func(ctx context.Context) error {
+		if client == nil {
+			return errors.New("firestore client is not initialized")
+		}
+		iter := client.Collection("members").Limit(1).Documents(ctx)
+		defer iter.Stop()
+		if _, err := iter.Next(); err != nil && !errors.Is(err, iterator.Done) {
+			return err
+		}
+		return nil
+	}
+}
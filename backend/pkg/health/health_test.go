@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Run(t *testing.T) {
+	c := NewChecker(50 * time.Millisecond)
+	c.Add("ok", func(ctx context.Context) error { return nil })
+	c.Add("broken", func(ctx context.Context) error { return errors.New("connection refused") })
+	c.Add("slow", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	report := c.Run(context.Background())
+
+	assert.Equal(t, StatusFail, report.Status)
+	assert.Equal(t, StatusOK, report.Checks["ok"].Status)
+	assert.Equal(t, StatusFail, report.Checks["broken"].Status)
+	assert.Equal(t, "connection refused", report.Checks["broken"].Error)
+	assert.Equal(t, StatusFail, report.Checks["slow"].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["slow"].Error)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "healthy", err: nil, wantCode: http.StatusOK},
+		{name: "unhealthy", err: errors.New("unreachable"), wantCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChecker(time.Second)
+			c.Add("firestore", func(ctx context.Context) error { return tt.err })
+
+			r := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(r)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			c.ReadinessHandler(ctx)
+
+			assert.Equal(t, tt.wantCode, r.Code)
+			var got Report
+			assert.NoError(t, json.Unmarshal(r.Body.Bytes(), &got))
+			assert.Contains(t, got.Checks, "firestore")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Package identity はログインに使う外部 ID プロバイダ（LINE や OAuth2 / OIDC）を共通のインターフェースで扱う。
+package identity
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrInvalidGrant は認可コードが無効・期限切れであることを表す。
+	ErrInvalidGrant = errors.New("identity: invalid grant")
+	// ErrInvalidToken は ID トークンやユーザー情報が検証に通らないことを表す。
+	ErrInvalidToken = errors.New("identity: invalid token")
+	// ErrNotConfigured はクライアント ID などの設定が足りないことを表す。
+	ErrNotConfigured = errors.New("identity: provider is not configured")
+)
+
+// Token は認可コードと交換したトークン。
+type Token struct {
+	AccessToken string
+	// IDToken は OIDC の ID トークン（発行されない場合は空）
+	IDToken string
+}
+
+// UserInfo はプロバイダが保証するユーザー情報。Subject がプロバイダ内で一意な ID。
+type UserInfo struct {
+	Subject     string
+	Email       string
+	DisplayName string
+	PictureURL  string
+}
+
+// Provider はログインに使う外部 ID プロバイダ。
+type Provider interface {
+	// Configured はログインに必要な設定が揃っているかを返す。
+	Configured() bool
+	// AuthorizeURL は認可画面の URL を返す。state / nonce はコールバックで照合する。
+	AuthorizeURL(state, nonce string) string
+	// Exchange は認可コードをトークンに交換する。
+	Exchange(ctx context.Context, code string) (*Token, error)
+	// UserInfo はトークンの持ち主を返す。ID トークンがあれば nonce も検証する。
+	UserInfo(ctx context.Context, token *Token, nonce string) (*UserInfo, error)
+}
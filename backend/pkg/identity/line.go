@@ -0,0 +1,75 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/line"
+)
+
+// ProviderLINE は LINE ログインのプロバイダ名。
+const ProviderLINE = "line"
+
+// lineProvider は LINE ログインを Provider として扱う。
+type lineProvider struct {
+	client *line.Client
+}
+
+// NewLINE は LINE クライアントを Provider に包む。
+func NewLINE(client *line.Client) Provider {
+	return &lineProvider{client: client}
+}
+
+func (p *lineProvider) Configured() bool {
+	return p.client.Configured()
+}
+
+func (p *lineProvider) AuthorizeURL(state, nonce string) string {
+	return p.client.AuthorizeURL(state, nonce)
+}
+
+func (p *lineProvider) Exchange(ctx context.Context, code string) (*Token, error) {
+	token, err := p.client.ExchangeCode(ctx, code)
+	if err != nil {
+		return nil, lineError(err)
+	}
+	return &Token{AccessToken: token.AccessToken, IDToken: token.IDToken}, nil
+}
+
+// UserInfo は ID トークンを検証し、プロフィールの userId が sub と一致することも確かめる。
+func (p *lineProvider) UserInfo(ctx context.Context, token *Token, nonce string) (*UserInfo, error) {
+	// openid スコープで発行された ID トークンだけを本人確認の根拠にする
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("%w: id_token is missing (openid scope was not granted)", ErrInvalidToken)
+	}
+	claims, err := p.client.VerifyIDToken(token.IDToken, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	profile, err := p.client.GetProfile(ctx, token.AccessToken)
+	if err != nil {
+		return nil, lineError(err)
+	}
+	if profile.UserID != claims.Subject {
+		return nil, fmt.Errorf("%w: line profile %s does not match id token", ErrInvalidToken, profile.UserID)
+	}
+	return &UserInfo{
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		DisplayName: profile.DisplayName,
+		PictureURL:  profile.PictureURL,
+	}, nil
+}
+
+// lineError は LINE クライアントのエラーを identity のエラーに対応づける。
+func lineError(err error) error {
+	switch {
+	case errors.Is(err, line.ErrInvalidGrant):
+		return fmt.Errorf("%w: %w", ErrInvalidGrant, err)
+	case errors.Is(err, line.ErrNotConfigured):
+		return fmt.Errorf("%w: %w", ErrNotConfigured, err)
+	}
+	return err
+}
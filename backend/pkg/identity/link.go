@@ -0,0 +1,94 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"golang.org/x/oauth2"
+)
+
+// Account はアカウント連携で取得した外部サービスのアカウント。
+type Account struct {
+	ID       string
+	Username string
+	// ProfileURL はプロフィールページの URL（ない場合は空）
+	ProfileURL string
+}
+
+// LinkConfig はアカウント連携に使うプロバイダごとの既定値と userinfo の読み方。
+// エンドポイントは config.OAuthProvider に指定があればそちらを使う。
+type LinkConfig struct {
+	AuthorizeURL string
+	TokenURL     string
+	APIBaseURL   string
+	// UserInfoPath は APIBaseURL からの userinfo のパス
+	UserInfoPath string
+	Scopes       []string
+	// AuthParams は認可画面の URL に足すパラメータ
+	AuthParams map[string]string
+	// Header は userinfo のリクエストに足すヘッダ
+	Header http.Header
+	// Decode は userinfo のレスポンスを Account にする。ID が取れなければエラーを返す
+	Decode func(body []byte) (*Account, error)
+}
+
+// LinkClient は外部アカウントをメンバーに紐づけるための OAuth2 クライアント。
+type LinkClient struct {
+	client     oauth2Client
+	authParams []oauth2.AuthCodeOption
+	decode     func(body []byte) (*Account, error)
+}
+
+// NewLinkClient は設定とプロバイダごとの既定値から LinkClient を生成する。
+func NewLinkClient(cfg config.OAuthProvider, lc LinkConfig, httpClient *http.Client) *LinkClient {
+	apiBaseURL := strings.TrimRight(orDefault(cfg.APIBaseURL, lc.APIBaseURL), "/")
+	c := &LinkClient{
+		client: oauth2Client{
+			oauth: &oauth2.Config{
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				RedirectURL:  cfg.RedirectURI,
+				Scopes:       lc.Scopes,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:   orDefault(cfg.AuthorizeURL, lc.AuthorizeURL),
+					TokenURL:  orDefault(cfg.TokenURL, lc.TokenURL),
+					AuthStyle: oauth2.AuthStyleInParams,
+				},
+			},
+			userInfoURL: apiBaseURL + lc.UserInfoPath,
+			header:      lc.Header,
+			httpClient:  httpClient,
+		},
+		decode: lc.Decode,
+	}
+	for k, v := range lc.AuthParams {
+		c.authParams = append(c.authParams, oauth2.SetAuthURLParam(k, v))
+	}
+	return c
+}
+
+// Configured は連携に必要な設定が揃っているかを返す。
+func (c *LinkClient) Configured() bool {
+	return c.client.configured()
+}
+
+// AuthorizeURL はユーザーをリダイレクトさせる認可画面の URL を返す。
+func (c *LinkClient) AuthorizeURL(state string) string {
+	return c.client.oauth.AuthCodeURL(state, c.authParams...)
+}
+
+// FetchAccount は認可コードをトークンに交換し、そのトークンの持ち主のアカウントを返す。
+// 無効・期限切れのコードは ErrInvalidGrant、設定不足は ErrNotConfigured。
+func (c *LinkClient) FetchAccount(ctx context.Context, code string) (*Account, error) {
+	token, err := c.client.exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.client.fetchUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return c.decode(body)
+}
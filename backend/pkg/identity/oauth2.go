@@ -0,0 +1,168 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"golang.org/x/oauth2"
+)
+
+// oauth2Client は認可コードの交換と userinfo の取得を行う OAuth2 クライアント。
+// 汎用ログイン（oauth2Provider）とアカウント連携（LinkClient）で共有する。
+type oauth2Client struct {
+	oauth       *oauth2.Config
+	userInfoURL string
+	// header は userinfo のリクエストに足すヘッダ
+	header     http.Header
+	httpClient *http.Client
+}
+
+func (c *oauth2Client) configured() bool {
+	return c.oauth.ClientID != "" && c.oauth.ClientSecret != "" && c.oauth.RedirectURL != "" &&
+		c.oauth.Endpoint.AuthURL != "" && c.oauth.Endpoint.TokenURL != "" && c.userInfoURL != ""
+}
+
+// exchange は認可コードをトークンに交換する。無効・期限切れのコードは ErrInvalidGrant。
+func (c *oauth2Client) exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	if !c.configured() {
+		return nil, ErrNotConfigured
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+	token, err := c.oauth.Exchange(ctx, code)
+	if err != nil {
+		// GitHub は無効なコードに 200 + error=bad_verification_code を返す
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) &&
+			(retrieveErr.ErrorCode == "invalid_grant" || retrieveErr.ErrorCode == "bad_verification_code") {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidGrant, retrieveErr.ErrorDescription)
+		}
+		return nil, fmt.Errorf("token exchange: %w", err)
+	}
+	return token, nil
+}
+
+// fetchUserInfo はアクセストークンで userinfo を取得し、レスポンスの本文を返す。
+func (c *oauth2Client) fetchUserInfo(ctx context.Context, accessToken string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.userInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("userinfo: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("userinfo: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo: status %d", res.StatusCode)
+	}
+	return body, nil
+}
+
+// oauth2Provider は設定だけで追加できる汎用の OAuth2 / OIDC プロバイダ。
+// ユーザー情報は userinfo エンドポイントから取る（ID トークンの署名検証はしない）。
+type oauth2Provider struct {
+	client oauth2Client
+	fields struct{ subject, email, name, picture string }
+}
+
+// NewOAuth2 は設定から汎用 OAuth2 プロバイダを生成する。
+func NewOAuth2(cfg config.IdentityProvider, httpClient *http.Client) Provider {
+	p := &oauth2Provider{
+		client: oauth2Client{
+			oauth: &oauth2.Config{
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				RedirectURL:  cfg.RedirectURI,
+				Scopes:       cfg.Scopes,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:   cfg.AuthorizeURL,
+					TokenURL:  cfg.TokenURL,
+					AuthStyle: oauth2.AuthStyleInParams,
+				},
+			},
+			userInfoURL: cfg.UserInfoURL,
+			httpClient:  httpClient,
+		},
+	}
+	p.fields.subject = orDefault(cfg.SubjectField, "sub")
+	p.fields.email = orDefault(cfg.EmailField, "email")
+	p.fields.name = orDefault(cfg.NameField, "name")
+	p.fields.picture = orDefault(cfg.PictureField, "picture")
+	return p
+}
+
+func (p *oauth2Provider) Configured() bool {
+	return p.client.configured()
+}
+
+func (p *oauth2Provider) AuthorizeURL(state, nonce string) string {
+	return p.client.oauth.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code string) (*Token, error) {
+	token, err := p.client.exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	idToken, _ := token.Extra("id_token").(string)
+	return &Token{AccessToken: token.AccessToken, IDToken: idToken}, nil
+}
+
+func (p *oauth2Provider) UserInfo(ctx context.Context, token *Token, nonce string) (*UserInfo, error) {
+	body, err := p.client.fetchUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 数値の ID（GitHub など）も桁落ちさせずに文字列にする
+	var fields map[string]any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("userinfo: %w", err)
+	}
+	info := &UserInfo{
+		Subject:     stringField(fields, p.fields.subject),
+		Email:       stringField(fields, p.fields.email),
+		DisplayName: stringField(fields, p.fields.name),
+		PictureURL:  stringField(fields, p.fields.picture),
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("%w: userinfo has no %q", ErrInvalidToken, p.fields.subject)
+	}
+	return info, nil
+}
+
+// stringField は userinfo の値を文字列で返す。文字列・数値以外や欠けている場合は空文字。
+func stringField(fields map[string]any, key string) string {
+	switch v := fields[key].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
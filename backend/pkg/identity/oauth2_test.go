@@ -0,0 +1,94 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeOAuth2 はトークン・userinfo エンドポイントを模したサーバを立て、それを向いた設定を返す。
+func newFakeOAuth2(t *testing.T, userinfo string) config.IdentityProvider {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Form.Get("code") != "good" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token":"at","token_type":"Bearer","id_token":"idt"}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(userinfo))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return config.IdentityProvider{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  "http://localhost:8080/api/auth/example/callback",
+		AuthorizeURL: srv.URL + "/authorize",
+		TokenURL:     srv.URL + "/token",
+		UserInfoURL:  srv.URL + "/userinfo",
+		Scopes:       []string{"openid", "email"},
+	}
+}
+
+func TestOAuth2Provider_OIDCUserInfo(t *testing.T) {
+	cfg := newFakeOAuth2(t, `{"sub":"1098","email":"taro@example.com","name":"Taro","picture":"https://example.com/p.png"}`)
+	p := NewOAuth2(cfg, http.DefaultClient)
+	ctx := context.Background()
+
+	token, err := p.Exchange(ctx, "good")
+	assert.NoError(t, err)
+	assert.Equal(t, "idt", token.IDToken)
+
+	info, err := p.UserInfo(ctx, token, "nonce")
+	assert.NoError(t, err)
+	assert.Equal(t, &UserInfo{Subject: "1098", Email: "taro@example.com", DisplayName: "Taro", PictureURL: "https://example.com/p.png"}, info)
+
+	_, err = p.Exchange(ctx, "expired")
+	assert.ErrorIs(t, err, ErrInvalidGrant)
+}
+
+func TestOAuth2Provider_CustomFields(t *testing.T) {
+	// GitHub の /user のように ID が数値でフィールド名も違う場合
+	cfg := newFakeOAuth2(t, `{"id":12345678901234567,"login":"octocat","avatar_url":"https://example.com/a.png"}`)
+	cfg.SubjectField, cfg.NameField, cfg.PictureField = "id", "login", "avatar_url"
+	p := NewOAuth2(cfg, http.DefaultClient)
+
+	info, err := p.UserInfo(context.Background(), &Token{AccessToken: "at"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567", info.Subject)
+	assert.Equal(t, "octocat", info.DisplayName)
+
+	// subject が取れなければ本人確認できないので失敗させる
+	cfg.SubjectField = "uid"
+	_, err = NewOAuth2(cfg, http.DefaultClient).UserInfo(context.Background(), &Token{AccessToken: "at"}, "")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestOAuth2Provider_AuthorizeURL(t *testing.T) {
+	p := NewOAuth2(newFakeOAuth2(t, `{}`), http.DefaultClient)
+	assert.True(t, p.Configured())
+
+	u, err := url.Parse(p.AuthorizeURL("state-1", "nonce-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "state-1", u.Query().Get("state"))
+	assert.Equal(t, "nonce-1", u.Query().Get("nonce"))
+	assert.Equal(t, "openid email", u.Query().Get("scope"))
+
+	assert.False(t, NewOAuth2(config.IdentityProvider{}, http.DefaultClient).Configured())
+}
@@ -2,12 +2,13 @@ package jwt
 
 import (
 	"errors"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type Manager struct {
-	secret []byte `json:"student_id"`
+	secret []byte
 }
 
 type Claims struct {
@@ -15,6 +16,11 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
+// NewManager は secret で署名・検証する Manager を生成する。
+func NewManager(secret []byte) *Manager {
+	return &Manager{secret: secret}
+}
+
 // JWTトークンを認証するだけの関数例
 func (m *Manager) AuthenticateJWT(tokenString string) (*Claims, error) {
 	// Claims 構造体に直接パースして、検証済みのクレームを返す
@@ -63,6 +69,14 @@ func CreateClaims(userID string, issuer string) Claims {
 	}
 }
 
+// CreateSessionClaims はログインセッション用に、発行時刻と有効期限付きのクレームを作成する。
+func CreateSessionClaims(userID string, issuer string, now time.Time, ttl time.Duration) Claims {
+	claims := CreateClaims(userID, issuer)
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(ttl))
+	return claims
+}
+
 // 以下は過去の実装例（コメントアウト）です。参考用に残しています。
 // 日本語の説明を付け加えています。
 //
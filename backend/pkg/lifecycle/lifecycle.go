@@ -0,0 +1,124 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Lifecycle はサーバ停止時の手順をまとめて管理する。
+// 停止は「readiness を落として待つ → 新規接続の受付停止 → 処理中リクエストのドレイン → バックグラウンド処理の完了待ち
+// → 依存リソースのクローズ」の順に行う。
+type Lifecycle struct {
+	draining atomic.Bool
+	// preStopDelay は readiness を落としてから受付を止めるまで待つ時間
+	preStopDelay time.Duration
+
+	workers      sync.WaitGroup
+	workerCtx    context.Context
+	cancelWorker context.CancelFunc
+
+	mu      sync.Mutex
+	closers []closer
+}
+
+type closer struct {
+	name string
+	fn   func() error
+}
+
+// New は Lifecycle を生成する。
+// preStopDelay はシャットダウン開始から受付停止までの待ち時間で、その間にロードバランサが
+// /readyz の失敗を見てこのインスタンスを振り分け先から外せるようにする。0 なら待たない。
+func New(preStopDelay time.Duration) *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Lifecycle{
+		preStopDelay: preStopDelay,
+		workerCtx:    ctx,
+		cancelWorker: cancel,
+	}
+}
+
+// Draining はシャットダウン（ドレイン）が始まっているかを返す。
+// readiness チェックはこれが true の間は失敗を返す。
+func (l *Lifecycle) Draining() bool {
+	return l.draining.Load()
+}
+
+// Go はバックグラウンド処理を起動する。
+// 渡される ctx はシャットダウン開始後（リクエストのドレイン完了後）にキャンセルされる。
+func (l *Lifecycle) Go(fn func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		fn(l.workerCtx)
+	}()
+}
+
+// OnClose はシャットダウンの最後に呼ぶクローズ処理を登録する。
+// 登録と逆の順番で呼ばれる（後から作ったものを先に閉じる）。
+func (l *Lifecycle) OnClose(name string, fn func() error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closers = append(l.closers, closer{name: name, fn: fn})
+}
+
+// Shutdown は draining にして preStopDelay だけ待ってから srv を停止し、
+// バックグラウンド処理の完了を待ってから登録済みのクローズ処理を実行する。
+// ctx の期限を過ぎた場合もクローズ処理は必ず実行し、発生したエラーをまとめて返す。
+func (l *Lifecycle) Shutdown(ctx context.Context, srv *http.Server) error {
+	l.draining.Store(true)
+
+	var errs []error
+
+	// --- ① readiness を落としたまま、ロードバランサが振り分けをやめるまで待つ ---
+	// この間に届いたリクエストは普通に処理する。ctx の期限が来たらすぐ次に進む
+	if l.preStopDelay > 0 {
+		timer := time.NewTimer(l.preStopDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+
+	// --- ② 新規接続の受付を止め、処理中のリクエストが終わるのを待つ ---
+	if srv != nil {
+		if err := srv.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("http server shutdown: %w", err))
+		}
+	}
+
+	// --- ③ バックグラウンド処理に停止を通知し、終了を待つ ---
+	l.cancelWorker()
+	done := make(chan struct{})
+	go func() {
+		l.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("background workers: %w", ctx.Err()))
+	}
+
+	// --- ④ Firestore クライアントなどの依存リソースを閉じる ---
+	l.mu.Lock()
+	closers := l.closers
+	l.closers = nil
+	l.mu.Unlock()
+	for i := len(closers) - 1; i >= 0; i-- {
+		c := closers[i]
+		if err := c.fn(); err != nil {
+			slog.Error("failed to close resource", "name", c.name, "error", err)
+			errs = append(errs, fmt.Errorf("close %s: %w", c.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
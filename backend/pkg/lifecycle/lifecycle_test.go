@@ -0,0 +1,128 @@
+package lifecycle
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown_DrainsInFlightRequestsBeforeClose(t *testing.T) {
+	l := New(0)
+
+	started := make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	record := func(s string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, s)
+	}
+
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		record("request")
+		w.WriteHeader(http.StatusOK)
+	})}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go srv.Serve(ln)
+
+	l.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		record("worker")
+	})
+	l.OnClose("first", func() error { record("close first"); return nil })
+	l.OnClose("second", func() error { record("close second"); return nil })
+
+	resCh := make(chan int, 1)
+	go func() {
+		res, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			resCh <- 0
+			return
+		}
+		res.Body.Close()
+		resCh <- res.StatusCode
+	}()
+	<-started
+
+	assert.False(t, l.Draining())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, l.Shutdown(ctx, srv))
+	assert.True(t, l.Draining())
+
+	assert.Equal(t, http.StatusOK, <-resCh)
+	assert.Equal(t, []string{"request", "worker", "close second", "close first"}, order)
+}
+
+func TestShutdown_ClosesResourcesEvenWhenTimedOut(t *testing.T) {
+	l := New(0)
+
+	block := make(chan struct{})
+	defer close(block)
+	l.Go(func(ctx context.Context) {
+		<-block
+	})
+	closed := false
+	l.OnClose("firestore", func() error { closed = true; return nil })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := l.Shutdown(ctx, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, closed)
+}
+
+func TestShutdown_KeepsServingDuringPreStopDelay(t *testing.T) {
+	l := New(200 * time.Millisecond)
+
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go srv.Serve(ln)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- l.Shutdown(ctx, srv) }()
+
+	// 待っている間は readiness だけ落とし、新しいリクエストも処理する
+	assert.Eventually(t, l.Draining, time.Second, 5*time.Millisecond)
+	res, err := http.Get("http://" + ln.Addr().String())
+	if assert.NoError(t, err) {
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	assert.NoError(t, <-done)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestShutdown_PreStopDelayStopsAtDeadline(t *testing.T) {
+	l := New(time.Minute)
+	closed := false
+	l.OnClose("firestore", func() error { closed = true; return nil })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	l.Shutdown(ctx, nil)
+
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, closed)
+}
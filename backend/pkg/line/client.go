@@ -0,0 +1,225 @@
+package line
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+)
+
+const (
+	// DefaultAPIBaseURL は LINE Platform API のベース URL。
+	DefaultAPIBaseURL = "https://api.line.me"
+	// DefaultAuthorizeURL は LINE Login の認可画面の URL。
+	DefaultAuthorizeURL = "https://access.line.me/oauth2/v2.1/authorize"
+	// LoginScope はログイン時に要求するスコープ。openid で ID トークンを、email でメールアドレスを受け取る。
+	LoginScope = "openid profile email"
+
+	defaultMaxRetries = 2
+	defaultBackoff    = 200 * time.Millisecond
+)
+
+// Client は LINE Login の API クライアント。
+// ベース URL を差し替えればローカルのスタブサーバに向けられる。
+type Client struct {
+	baseURL       string
+	authorizeURL  string
+	httpClient    *http.Client
+	channelID     string
+	channelSecret string
+	redirectURI   string
+	maxRetries    int
+	backoff       time.Duration
+	now           func() time.Time
+}
+
+// NewClient は設定から Client を生成する。httpClient には計装済みのクライアントを渡す。
+func NewClient(cfg config.LINE, httpClient *http.Client) *Client {
+	baseURL := cfg.APIBaseURL
+	if baseURL == "" {
+		baseURL = DefaultAPIBaseURL
+	}
+	authorizeURL := cfg.AuthorizeURL
+	if authorizeURL == "" {
+		authorizeURL = DefaultAuthorizeURL
+	}
+	maxRetries := defaultMaxRetries
+	if cfg.MaxRetries != nil {
+		maxRetries = max(*cfg.MaxRetries, 0)
+	}
+	return &Client{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		authorizeURL:  authorizeURL,
+		httpClient:    httpClient,
+		channelID:     cfg.ChannelID,
+		channelSecret: cfg.ChannelSecret,
+		redirectURI:   cfg.RedirectURI,
+		maxRetries:    maxRetries,
+		backoff:       defaultBackoff,
+		now:           time.Now,
+	}
+}
+
+// Configured はログインに必要な設定が揃っているかを返す。
+func (c *Client) Configured() bool {
+	return c.channelID != "" && c.channelSecret != "" && c.redirectURI != ""
+}
+
+// AuthorizeURL はユーザーをリダイレクトさせる LINE の認可画面の URL を組み立てる。
+// state は CSRF 対策、nonce は ID トークンのリプレイ対策に使い、どちらもコールバックで照合する。
+func (c *Client) AuthorizeURL(state, nonce string) string {
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", c.channelID)
+	q.Set("redirect_uri", c.redirectURI)
+	q.Set("state", state)
+	q.Set("scope", LoginScope)
+	q.Set("nonce", nonce)
+	return c.authorizeURL + "?" + q.Encode()
+}
+
+// Token はトークンエンドポイントのレスポンス。
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token"`
+	Scope        string `json:"scope"`
+	TokenType    string `json:"token_type"`
+}
+
+// Profile は /v2/profile のレスポンス。
+type Profile struct {
+	UserID        string `json:"userId"`
+	DisplayName   string `json:"displayName"`
+	PictureURL    string `json:"pictureUrl"`
+	StatusMessage string `json:"statusMessage"`
+}
+
+// ExchangeCode は認可コードをアクセストークンに交換する。
+// コードが無効な場合は ErrInvalidGrant（errors.Is で判定可）を返す。
+// 認可コードは 1 回しか使えず、送れたかどうか分からないまま再送すると invalid_grant になるのでリトライしない。
+func (c *Client) ExchangeCode(ctx context.Context, code string) (*Token, error) {
+	if !c.Configured() {
+		return nil, ErrNotConfigured
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.redirectURI)
+	form.Set("client_id", c.channelID)
+	form.Set("client_secret", c.channelSecret)
+
+	var token Token
+	err := c.do(ctx, "token", false, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/oauth2/v2.1/token", strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+	}, &token)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetProfile はアクセストークンの持ち主のプロフィールを取得する。
+func (c *Client) GetProfile(ctx context.Context, accessToken string) (*Profile, error) {
+	var profile Profile
+	err := c.do(ctx, "profile", true, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/v2/profile", nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		return req, nil
+	}, &profile)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// do はリクエストを送り、2xx なら out にデコードする。
+// idempotent なリクエストに限り、通信エラーと 5xx は指数バックオフで maxRetries 回までリトライする。4xx はリトライしない。
+func (c *Client) do(ctx context.Context, endpoint string, idempotent bool, newReq func() (*http.Request, error), out any) error {
+	retries := 0
+	if idempotent {
+		retries = c.maxRetries
+	}
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			wait := c.backoff << (attempt - 1)
+			select {
+			case <-ctx.Done():
+				return errors.Join(lastErr, ctx.Err())
+			case <-time.After(wait):
+			}
+		}
+
+		req, err := newReq()
+		if err != nil {
+			return err
+		}
+		res, err := c.httpClient.Do(req)
+		metrics.LINECall(endpoint, res, err)
+		if err != nil {
+			lastErr = fmt.Errorf("line %s: %w", endpoint, err)
+			continue
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("line %s: read body: %w", endpoint, err)
+			continue
+		}
+
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			apiErr := newAPIError(endpoint, res.StatusCode, body)
+			if apiErr.ServerError() {
+				lastErr = apiErr
+				continue
+			}
+			return apiErr
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("line %s: decode response: %w", endpoint, err)
+		}
+		return nil
+	}
+	return lastErr
+}
+
+func newAPIError(endpoint string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{Endpoint: endpoint, StatusCode: statusCode}
+	var payload struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		Message          string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Code = payload.Error
+		apiErr.Description = payload.ErrorDescription
+		if apiErr.Description == "" {
+			apiErr.Description = payload.Message
+		}
+	}
+	if apiErr.Code == "" && apiErr.Description == "" {
+		apiErr.Description = http.StatusText(statusCode)
+	}
+	return apiErr
+}
@@ -0,0 +1,152 @@
+package line
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c := NewClient(config.LINE{
+		ChannelID:     "1234567890",
+		ChannelSecret: "secret",
+		RedirectURI:   "http://localhost:8080/api/line-oauth",
+		APIBaseURL:    srv.URL,
+	}, srv.Client())
+	c.backoff = time.Millisecond
+	return c
+}
+
+func TestGetProfile_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"userId":"U123","displayName":"taro"}`))
+	})
+
+	profile, err := c.GetProfile(context.Background(), "at")
+	assert.NoError(t, err)
+	assert.Equal(t, "U123", profile.UserID)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestNewClient_MaxRetries(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cfg  *int
+		want int
+	}{
+		{name: "default", cfg: nil, want: defaultMaxRetries},
+		{name: "explicit zero", cfg: new(int), want: 0},
+		{name: "negative", cfg: func() *int { n := -1; return &n }(), want: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(config.LINE{MaxRetries: tt.cfg}, http.DefaultClient)
+			assert.Equal(t, tt.want, c.maxRetries)
+		})
+	}
+}
+
+func TestExchangeCode_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantCalls    int32
+		invalidGrant bool
+		serverError  bool
+	}{
+		{name: "invalid grant is not retried", status: http.StatusBadRequest, body: `{"error":"invalid_grant","error_description":"expired"}`, wantCalls: 1, invalidGrant: true},
+		{name: "other client error", status: http.StatusBadRequest, body: `{"error":"invalid_request"}`, wantCalls: 1},
+		// 認可コードは使い捨てなので、5xx でも再送しない
+		{name: "server error is not retried", status: http.StatusInternalServerError, body: `oops`, wantCalls: 1, serverError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := c.ExchangeCode(context.Background(), "code")
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.invalidGrant, errors.Is(err, ErrInvalidGrant))
+			assert.Equal(t, tt.serverError, apiErr.ServerError())
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	c := NewClient(config.LINE{ChannelID: "1234567890", ChannelSecret: "secret", RedirectURI: "http://localhost"}, http.DefaultClient)
+	c.now = func() time.Time { return now }
+
+	sign := func(secret string, mutate func(*IDTokenClaims)) string {
+		claims := &IDTokenClaims{
+			Nonce: "n-1",
+			Email: "taro@example.com",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    Issuer,
+				Subject:   "U123",
+				Audience:  jwt.ClaimStrings{"1234567890"},
+				IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			},
+		}
+		if mutate != nil {
+			mutate(claims)
+		}
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		assert.NoError(t, err)
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		nonce   string
+		wantErr bool
+	}{
+		{name: "valid", token: sign("secret", nil), nonce: "n-1"},
+		{name: "wrong signature", token: sign("other", nil), nonce: "n-1", wantErr: true},
+		{name: "wrong issuer", token: sign("secret", func(c *IDTokenClaims) { c.Issuer = "https://evil.example.com" }), nonce: "n-1", wantErr: true},
+		{name: "wrong audience", token: sign("secret", func(c *IDTokenClaims) { c.Audience = jwt.ClaimStrings{"999"} }), nonce: "n-1", wantErr: true},
+		{name: "expired", token: sign("secret", func(c *IDTokenClaims) { c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Second)) }), nonce: "n-1", wantErr: true},
+		{name: "nonce mismatch", token: sign("secret", nil), nonce: "n-2", wantErr: true},
+		{name: "nonce is required", token: sign("secret", func(c *IDTokenClaims) { c.Nonce = "" }), nonce: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := c.VerifyIDToken(tt.token, tt.nonce)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidIDToken)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "U123", claims.Subject)
+			assert.Equal(t, "taro@example.com", claims.Email)
+		})
+	}
+}
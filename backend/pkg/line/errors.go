@@ -0,0 +1,40 @@
+package line
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidGrant は認可コードが無効・期限切れ・使用済みであることを表す（ユーザーにやり直してもらう）。
+	ErrInvalidGrant = errors.New("line: invalid grant")
+	// ErrInvalidIDToken は ID トークンの検証に失敗したことを表す。
+	ErrInvalidIDToken = errors.New("line: invalid id token")
+	// ErrNotConfigured はチャネル ID などの設定が足りないことを表す。
+	ErrNotConfigured = errors.New("line: client is not configured")
+)
+
+// APIError は LINE API が 2xx 以外を返したときのエラー。
+type APIError struct {
+	Endpoint    string
+	StatusCode  int
+	Code        string // レスポンスの "error"（例: invalid_grant）
+	Description string // レスポンスの "error_description" / "message"
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("line %s: status %d: %s: %s", e.Endpoint, e.StatusCode, e.Code, e.Description)
+	}
+	return fmt.Sprintf("line %s: status %d: %s", e.Endpoint, e.StatusCode, e.Description)
+}
+
+// Is は errors.Is(err, ErrInvalidGrant) で invalid_grant を判定できるようにする。
+func (e *APIError) Is(target error) bool {
+	return target == ErrInvalidGrant && e.Code == "invalid_grant"
+}
+
+// ServerError は LINE 側の障害（5xx）かどうかを返す。リトライ対象になる。
+func (e *APIError) ServerError() bool {
+	return e.StatusCode >= 500
+}
@@ -0,0 +1,52 @@
+package line
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Issuer は LINE Login が発行する ID トークンの iss。
+const Issuer = "https://access.line.me"
+
+// IDTokenClaims は LINE の ID トークンのペイロード。
+type IDTokenClaims struct {
+	Nonce   string   `json:"nonce"`
+	AMR     []string `json:"amr"`
+	Name    string   `json:"name"`
+	Picture string   `json:"picture"`
+	Email   string   `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// VerifyIDToken は ID トークンの署名（チャネルシークレットによる HS256）、iss、aud（チャネル ID）、
+// exp、nonce を検証し、ペイロードを返す。nonce は必須で、空なら ErrInvalidIDToken を返す（リプレイを防げないため）。
+func (c *Client) VerifyIDToken(idToken, nonce string) (*IDTokenClaims, error) {
+	if c.channelID == "" || c.channelSecret == "" {
+		return nil, ErrNotConfigured
+	}
+	if nonce == "" {
+		return nil, fmt.Errorf("%w: nonce is required", ErrInvalidIDToken)
+	}
+
+	claims := &IDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(c.channelSecret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(c.channelID),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(c.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	return claims, nil
+}
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader はリクエスト ID を受け渡すヘッダ名。
+const RequestIDHeader = "X-Request-ID"
+
+// 受け取ったリクエスト ID をそのまま使う最大長（ログ汚染防止）
+const maxRequestIDLength = 128
+
+type loggerKey struct{}
+type requestIDKey struct{}
+
+// WithLogger は logger を ctx に埋め込む。
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext はリクエストスコープのロガーを返す。埋め込まれていなければ slog.Default() を返す。
+// handler / service のどちらからでも ctx を渡せば request_id 付きのログが出せる。
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// RequestID は ctx に埋め込まれたリクエスト ID を返す。なければ空文字。
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Middleware は X-Request-ID の採番（または引き継ぎ）と、1 リクエスト 1 レコードのアクセスログ出力を行う。
+func Middleware(base *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		// --- ① リクエスト ID を決める（クライアント/LB から来ていれば引き継ぐ） ---
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = newRequestID()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		// --- ② request_id 付きのロガーを ctx に載せて後続へ渡す ---
+		logger := base.With("request_id", requestID)
+		ctx := context.WithValue(c.Request.Context(), requestIDKey{}, requestID)
+		c.Request = c.Request.WithContext(WithLogger(ctx, logger))
+
+		c.Next()
+
+		// --- ③ アクセスログ（route はパスパラメータ展開前のテンプレート） ---
+		status := c.Writer.Status()
+		attrs := []any{
+			"method", c.Request.Method,
+			"route", c.FullPath(),
+			"path", c.Request.URL.Path,
+			"status", status,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"client_ip", c.ClientIP(),
+		}
+		if userID := c.GetString("user_id"); userID != "" {
+			attrs = append(attrs, "user_id", userID)
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, "errors", c.Errors.String())
+		}
+
+		switch {
+		case status >= 500:
+			logger.Error("request", attrs...)
+		case status >= 400:
+			logger.Warn("request", attrs...)
+		default:
+			logger.Info("request", attrs...)
+		}
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
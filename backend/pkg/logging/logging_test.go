@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		requestID string
+	}{
+		{name: "propagates incoming id", requestID: "req-123"},
+		{name: "generates id", requestID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			base := slog.New(slog.NewJSONHandler(&buf, nil))
+
+			router := gin.New()
+			router.Use(Middleware(base))
+			router.GET("/api/members/:id", func(c *gin.Context) {
+				c.Set("user_id", "U1")
+				FromContext(c.Request.Context()).Warn("skip")
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/members/abc", nil)
+			if tt.requestID != "" {
+				req.Header.Set(RequestIDHeader, tt.requestID)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			gotID := w.Header().Get(RequestIDHeader)
+			assert.NotEmpty(t, gotID)
+			if tt.requestID != "" {
+				assert.Equal(t, tt.requestID, gotID)
+			}
+
+			var records []map[string]any
+			sc := bufio.NewScanner(&buf)
+			for sc.Scan() {
+				var rec map[string]any
+				assert.NoError(t, json.Unmarshal(sc.Bytes(), &rec))
+				records = append(records, rec)
+			}
+			if assert.Len(t, records, 2) {
+				// handler 内のログにも request_id が付く
+				assert.Equal(t, gotID, records[0]["request_id"])
+				access := records[1]
+				assert.Equal(t, gotID, access["request_id"])
+				assert.Equal(t, "/api/members/:id", access["route"])
+				assert.Equal(t, float64(http.StatusOK), access["status"])
+				assert.Equal(t, "U1", access["user_id"])
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry はこのサーバのメトリクスを登録するレジストリ。/metrics で公開する。
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP リクエスト数",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP リクエストの処理時間（秒）",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	firestoreReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "firestore_document_reads_total",
+		Help: "Firestore から読み込んだドキュメント数",
+	}, []string{"collection"})
+
+	firestoreWrites = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "firestore_document_writes_total",
+		Help: "Firestore に書き込んだドキュメント数",
+	}, []string{"collection"})
+
+	firestoreErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "firestore_errors_total",
+		Help: "Firestore 操作の失敗数",
+	}, []string{"collection", "op"})
+
+	lineCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "line_api_requests_total",
+		Help: "LINE API 呼び出しの結果別件数",
+	}, []string{"endpoint", "outcome"})
+
+	malformedMembers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "members_malformed_documents_skipped_total",
+		Help: "GetApiMembers でデコードできずに一覧から除外したメンバードキュメント数",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		firestoreReads,
+		firestoreWrites,
+		firestoreErrors,
+		lineCalls,
+		malformedMembers,
+	)
+}
+
+// Handler は /metrics 用のハンドラを返す。
+func Handler() gin.HandlerFunc {
+	h := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+	return gin.WrapH(h)
+}
+
+// Middleware はリクエスト数と処理時間をルートテンプレート・ステータス別に記録する。
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// パスをそのままラベルにするとカーディナリティが爆発するので、テンプレート（/api/members/:id）を使う
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		httpRequests.WithLabelValues(c.Request.Method, route, status).Inc()
+		httpDuration.WithLabelValues(c.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// FirestoreRead は collection から n 件読み込んだことを記録する。
+func FirestoreRead(collection string, n int) {
+	firestoreReads.WithLabelValues(collection).Add(float64(n))
+}
+
+// FirestoreWrite は collection に n 件書き込んだことを記録する。
+func FirestoreWrite(collection string, n int) {
+	firestoreWrites.WithLabelValues(collection).Add(float64(n))
+}
+
+// FirestoreError は collection に対する op（read / write）の失敗を記録する。
+func FirestoreError(collection, op string) {
+	firestoreErrors.WithLabelValues(collection, op).Inc()
+}
+
+// LINECall は LINE API 呼び出しの結果を記録する。
+// outcome は success / client_error / server_error / network_error のいずれかに分類する。
+func LINECall(endpoint string, res *http.Response, err error) {
+	outcome := "success"
+	switch {
+	case err != nil:
+		outcome = "network_error"
+	case res.StatusCode >= 500:
+		outcome = "server_error"
+	case res.StatusCode >= 400:
+		outcome = "client_error"
+	}
+	lineCalls.WithLabelValues(endpoint, outcome).Inc()
+}
+
+// MalformedMemberSkipped は壊れたメンバードキュメントを一覧から除外したことを記録する。
+func MalformedMemberSkipped() {
+	malformedMembers.Inc()
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware_LabelsByRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/api/members/:id", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+	router.GET("/metrics", Handler())
+
+	for _, id := range []string{"a", "b"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/members/"+id, nil))
+	}
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, "/api/members/:id", "404")))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), `http_request_duration_seconds_bucket{method="GET",route="/api/members/:id",status="404"`))
+}
+
+func TestLINECall_Outcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		res     *http.Response
+		err     error
+		outcome string
+	}{
+		{name: "success", res: &http.Response{StatusCode: http.StatusOK}, outcome: "success"},
+		{name: "client error", res: &http.Response{StatusCode: http.StatusBadRequest}, outcome: "client_error"},
+		{name: "server error", res: &http.Response{StatusCode: http.StatusBadGateway}, outcome: "server_error"},
+		{name: "network error", err: errors.New("dial tcp: timeout"), outcome: "network_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := testutil.ToFloat64(lineCalls.WithLabelValues("test", tt.outcome))
+			LINECall("test", tt.res, tt.err)
+			assert.Equal(t, before+1, testutil.ToFloat64(lineCalls.WithLabelValues("test", tt.outcome)))
+		})
+	}
+}
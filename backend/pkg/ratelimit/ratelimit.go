@@ -0,0 +1,172 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// Limit はトークンバケットの設定。Rate は 1 秒あたりに補充されるトークン数、Burst はバケットの容量。
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute は 1 分あたり n 回・バースト burst 回の Limit を作る。
+func PerMinute(n, burst int) Limit {
+	return Limit{Rate: float64(n) / 60, Burst: burst}
+}
+
+// Result は 1 回分の判定結果。
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Store はキーごとのトークンバケットを保持する。
+// 複数インスタンスで制限を共有したい場合は Redis などでこのインターフェースを実装する。
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryStore はプロセス内でバケットを保持する Store。インスタンスごとに独立して数える。
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// NewMemoryStore は MemoryStore を生成する。
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Take はトークンを 1 つ消費できるか判定する。
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+
+	// 前回からの経過時間分だけ補充する（容量は Burst まで）
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Result{Allowed: true, Remaining: int(b.tokens)}, nil
+	}
+
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return Result{Allowed: false, RetryAfter: wait}, nil
+}
+
+// Cleanup は idle 以上使われていないバケットを捨てる。
+func (s *MemoryStore) Cleanup(idle time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, b := range s.buckets {
+		if now.Sub(b.last) > idle {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// Run は ctx がキャンセルされるまで interval ごとに Cleanup する。
+func (s *MemoryStore) Run(ctx context.Context, interval, idle time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Cleanup(idle)
+		}
+	}
+}
+
+// KeyFunc はリクエストから制限の単位となるキーを取り出す。
+type KeyFunc func(c *gin.Context) string
+
+// ByIP はクライアント IP ごとに制限する。
+// X-Forwarded-For は gin の SetTrustedProxies で信用したプロキシからのものだけが使われる（main.go の trusted_proxies）。
+func ByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// ByUser は認証済みユーザーごとに制限する。未認証の場合は IP で代用する。
+func ByUser(c *gin.Context) string {
+	if userID := c.GetString("user_id"); userID != "" {
+		return "user:" + userID
+	}
+	return ByIP(c)
+}
+
+// Rule はルートグループ 1 つ分の制限。
+type Rule struct {
+	Name   string
+	Routes []string // "METHOD /route/template" 形式（例: "POST /api/members"）
+	Limit  Limit
+	Key    KeyFunc
+}
+
+// Middleware は rules に含まれるルートだけを制限するミドルウェアを返す。
+// 制限を超えたら 429 と Retry-After（秒）を返す。Store のエラー時は通す（fail open）。
+func Middleware(store Store, rules ...Rule) gin.HandlerFunc {
+	byRoute := make(map[string]Rule)
+	for _, r := range rules {
+		for _, route := range r.Routes {
+			byRoute[route] = r
+		}
+	}
+
+	return func(c *gin.Context) {
+		rule, ok := byRoute[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		key := rule.Name + ":" + rule.Key(c)
+		res, err := store.Take(ctx, key, rule.Limit)
+		if err != nil {
+			logging.FromContext(ctx).Error("rate limit store error", "rule", rule.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(rule.Limit.Burst))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		if !res.Allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many requests"})
+			return
+		}
+		c.Next()
+	}
+}
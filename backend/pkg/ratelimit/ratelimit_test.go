@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	limit := PerMinute(60, 2) // 1 秒に 1 トークン、最大 2
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		res, err := s.Take(ctx, "k", limit)
+		assert.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	res, _ := s.Take(ctx, "k", limit)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+
+	// 別のキーは独立して数える
+	res, _ = s.Take(ctx, "other", limit)
+	assert.True(t, res.Allowed)
+
+	// 1 秒経てば 1 トークン補充される
+	now = now.Add(time.Second)
+	res, _ = s.Take(ctx, "k", limit)
+	assert.True(t, res.Allowed)
+
+	now = now.Add(time.Hour)
+	s.Cleanup(time.Minute)
+	assert.Empty(t, s.buckets)
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Middleware(NewMemoryStore(), Rule{
+		Name:   "write",
+		Routes: []string{"POST /api/members"},
+		Limit:  PerMinute(1, 1),
+		Key:    ByIP,
+	}))
+	router.POST("/api/members", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	router.GET("/api/members", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, "/api/members", nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusCreated, do(http.MethodPost).Code)
+
+	w := do(http.MethodPost)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	// 対象外のルートは制限しない
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, do(http.MethodGet).Code)
+	}
+}
+
+func TestByIP_IgnoresUntrustedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	// main.go と同じく、trusted_proxies が空なら X-Forwarded-For を信用しない
+	assert.NoError(t, router.SetTrustedProxies(nil))
+	router.Use(Middleware(NewMemoryStore(), Rule{
+		Name:   "auth",
+		Routes: []string{"GET /api/line-login"},
+		Limit:  PerMinute(1, 1),
+		Key:    ByIP,
+	}))
+	router.GET("/api/line-login", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(forwardedFor string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/line-login", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, do("203.0.113.1"))
+	// X-Forwarded-For を毎回変えても同じ接続元として制限する
+	assert.Equal(t, http.StatusTooManyRequests, do("203.0.113.2"))
+}
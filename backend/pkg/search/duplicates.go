@@ -0,0 +1,141 @@
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+)
+
+// 重複の理由
+const (
+	ReasonStudentID  = "student_id"
+	ReasonLineUserID = "line_user_id"
+	ReasonEmail      = "email"
+	ReasonName       = "name"
+)
+
+// 理由ごとの同じ人らしさ。学籍番号と LINE は 1 人に 1 つなので確実、メールは共有されることがあるので少し下げる。
+var reasonScores = map[string]float64{
+	ReasonStudentID:  1,
+	ReasonLineUserID: 1,
+	ReasonEmail:      0.95,
+}
+
+// Duplicate は同じ人が二重に登録されていそうなメンバーの組。
+type Duplicate struct {
+	Members [2]service.Member
+	// Score は同じ人らしさ（0〜1）
+	Score   float64
+	Reasons []string
+}
+
+type nameKey struct {
+	text   string // Normalize 済み
+	romaji string // かなかローマ字だけの名前のときのローマ字（漢字を含む名前は読みが分からないので空）
+}
+
+func newNameKey(name string) nameKey {
+	k := nameKey{text: Normalize(name)}
+	switch {
+	case isASCIIWord(k.text):
+		k.romaji = canonicalRomaji(k.text)
+	case isKana(k.text):
+		k.romaji = Romanize(k.text)
+	}
+	return k
+}
+
+func isKana(s string) bool {
+	for _, r := range s {
+		if !(r >= 'ぁ' && r <= 'ゖ') && r != 'ー' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// FindDuplicates は members から同じ人らしい組を、minScore 以上のものだけ疑わしい順に返す。
+// 学籍番号・LINE ユーザーID・メールアドレスの一致と、名前の近さ（Normalize した名前の編集距離。
+// かなとローマ字だけの名前はローマ字でも比べる）から判定し、いちばん高いものを Score にする。
+func FindDuplicates(members []service.Member, minScore float64) []Duplicate {
+	keys := make([]nameKey, len(members))
+	for i := range members {
+		keys[i] = newNameKey(members[i].Name)
+	}
+
+	out := make([]Duplicate, 0)
+	for i := range members {
+		for j := i + 1; j < len(members); j++ {
+			a, b := &members[i], &members[j]
+			d := Duplicate{Members: [2]service.Member{*a, *b}}
+			add := func(reason string, score float64) {
+				if score < minScore {
+					return
+				}
+				d.Reasons = append(d.Reasons, reason)
+				d.Score = max(d.Score, score)
+			}
+			if a.StudentID != "" && a.StudentID == b.StudentID {
+				add(ReasonStudentID, reasonScores[ReasonStudentID])
+			}
+			if a.LineUserID != "" && a.LineUserID == b.LineUserID {
+				add(ReasonLineUserID, reasonScores[ReasonLineUserID])
+			}
+			if a.Email != "" && strings.EqualFold(a.Email, b.Email) {
+				add(ReasonEmail, reasonScores[ReasonEmail])
+			}
+			add(ReasonName, nameSimilarity(keys[i], keys[j]))
+			if len(d.Reasons) > 0 {
+				out = append(out, d)
+			}
+		}
+	}
+	slices.SortStableFunc(out, func(a, b Duplicate) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), strings.Compare(a.Members[0].Name, b.Members[0].Name))
+	})
+	return out
+}
+
+// nameSimilarity は名前の近さ（1 - 編集距離 / 長いほうの文字数）を返す。どちらかの名前が空なら 0。
+func nameSimilarity(a, b nameKey) float64 {
+	if a.text == "" || b.text == "" {
+		return 0
+	}
+	score := similarity(a.text, b.text)
+	if a.romaji != "" && b.romaji != "" {
+		score = max(score, similarity(a.romaji, b.romaji))
+	}
+	return score
+}
+
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein は a と b の編集距離（挿入・削除・置換を 1 と数える）を返す。
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,39 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	members := []service.Member{
+		{Id: "a", Name: "田中 太郎", StudentID: "24T0001"},
+		{Id: "b", Name: "たなか たろう", StudentID: "24T0001"},
+		{Id: "c", Name: "さとう はなこ", Email: "hana@example.com"},
+		{Id: "d", Name: "Sato Hanako", Email: "HANA@example.com"},
+		{Id: "e", Name: "鈴木 一郎", LineUserID: "U1"},
+		{Id: "f", Name: "山本 次郎", LineUserID: "U2"},
+	}
+
+	got := FindDuplicates(members, 0.8)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "a", got[0].Members[0].Id)
+		assert.Equal(t, "b", got[0].Members[1].Id)
+		assert.Equal(t, 1.0, got[0].Score)
+		assert.Equal(t, []string{ReasonStudentID}, got[0].Reasons)
+
+		assert.Equal(t, "c", got[1].Members[0].Id)
+		assert.Equal(t, "d", got[1].Members[1].Id)
+		// メールは大文字小文字を区別せず、かなとローマ字の名前も同じ人とみなす
+		assert.Equal(t, []string{ReasonEmail, ReasonName}, got[1].Reasons)
+	}
+
+	// 名前の表記ゆれだけでも閾値を下げれば出る
+	got = FindDuplicates([]service.Member{{Id: "x", Name: "わたなべ けんた"}, {Id: "y", Name: "わたなべ けんと"}}, 0.8)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, []string{ReasonName}, got[0].Reasons)
+	}
+	assert.Empty(t, FindDuplicates([]service.Member{{Id: "x", Name: "わたなべ けんた"}, {Id: "y", Name: "わたなべ けんと"}}, 0.95))
+}
@@ -0,0 +1,199 @@
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+)
+
+// 検索対象のフィールド。重みが大きいほど一致したときの順位が上がる。
+const (
+	FieldName     = "name"
+	FieldNickname = "nickname"
+	FieldRoles    = "roles"
+	FieldBio      = "bio"
+)
+
+var fieldWeights = map[string]float64{
+	FieldName:     10,
+	FieldNickname: 8,
+	FieldRoles:    4,
+	FieldBio:      1,
+}
+
+// 一致の種類ごとの倍率。ローマ字での一致は表記の推測を含むので少し下げる。
+const (
+	exactMatch  = 3.0
+	prefixMatch = 2.0
+	substrMatch = 1.0
+	romajiScale = 0.8
+)
+
+// Hit は検索結果の 1 件。
+type Hit struct {
+	Member service.Member
+	Score  float64
+	// Fields は一致したフィールド（name / nickname / roles / bio）
+	Fields []string
+}
+
+type field struct {
+	name   string
+	text   string // Normalize 済み
+	romaji string // Romanize 済み（かなを含まなければ空）
+}
+
+type document struct {
+	member service.Member
+	fields []field
+}
+
+// Index はメンバー検索用のインメモリ索引。Rebuild で作り直すまで内容は変わらない。
+// 複数のリクエストから同時に使ってよい。
+type Index struct {
+	mu      sync.RWMutex
+	docs    []document
+	builtAt time.Time
+	dirty   bool
+}
+
+// NewIndex は空の Index を生成する。最初の検索の前に Rebuild すること。
+func NewIndex() *Index {
+	return &Index{dirty: true}
+}
+
+// Rebuild は members から索引を作り直す。
+func (ix *Index) Rebuild(members []service.Member, now time.Time) {
+	docs := make([]document, 0, len(members))
+	for _, m := range members {
+		d := document{member: m}
+		add := func(name, text string) {
+			if n := Normalize(text); n != "" {
+				d.fields = append(d.fields, field{name: name, text: n, romaji: Romanize(n)})
+			}
+		}
+		add(FieldName, m.Name)
+		add(FieldNickname, m.Nickname)
+		for _, r := range m.Roles {
+			add(FieldRoles, r)
+		}
+		add(FieldBio, m.Bio)
+		docs = append(docs, d)
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.docs, ix.builtAt, ix.dirty = docs, now, false
+}
+
+// Invalidate は次の検索の前に作り直しが必要なことを記録する（メンバーの登録・更新後に呼ぶ）。
+func (ix *Index) Invalidate() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.dirty = true
+}
+
+// NeedsRebuild は Invalidate されたか、最後に作ってから maxAge 以上たっているかを返す。
+func (ix *Index) NeedsRebuild(now time.Time, maxAge time.Duration) bool {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.dirty || now.Sub(ix.builtAt) >= maxAge
+}
+
+// Search は q に一致するメンバーをスコアの高い順に最大 limit 件返す。
+// q は空白で区切った語の AND 検索で、各語はどれかのフィールドに含まれていればよい。
+// visible が false を返すメンバーは結果に含めない。
+func (ix *Index) Search(q string, limit int, visible func(*service.Member) bool) []Hit {
+	terms := queryTerms(q)
+	if len(terms) == 0 {
+		return []Hit{}
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	hits := make([]Hit, 0)
+	for i := range ix.docs {
+		d := &ix.docs[i]
+		if visible != nil && !visible(&d.member) {
+			continue
+		}
+		if hit, ok := d.match(terms); ok {
+			hits = append(hits, hit)
+		}
+	}
+	slices.SortStableFunc(hits, func(a, b Hit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Member.Name, b.Member.Name)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+type queryTerm struct {
+	text   string
+	romaji string // ローマ字として比べる形（英数字だけの語、またはかなの語）
+}
+
+func queryTerms(q string) []queryTerm {
+	var terms []queryTerm
+	for _, w := range strings.Fields(q) {
+		t := queryTerm{text: Normalize(w)}
+		if t.text == "" {
+			continue
+		}
+		if isASCIIWord(t.text) {
+			t.romaji = canonicalRomaji(t.text)
+		} else {
+			t.romaji = Romanize(t.text)
+		}
+		terms = append(terms, t)
+	}
+	return terms
+}
+
+// match は全ての語がどこかのフィールドに一致すればスコアを付けて返す。
+// 1 語ごとに最もよく一致したフィールドのスコアを足し合わせる。
+func (d *document) match(terms []queryTerm) (Hit, bool) {
+	hit := Hit{Member: d.member}
+	for _, t := range terms {
+		best, bestField := 0.0, ""
+		for _, f := range d.fields {
+			score := matchScore(f.text, t.text)
+			if score == 0 && t.romaji != "" && f.romaji != "" {
+				score = matchScore(f.romaji, t.romaji) * romajiScale
+			}
+			if score *= fieldWeights[f.name]; score > best {
+				best, bestField = score, f.name
+			}
+		}
+		if best == 0 {
+			return Hit{}, false
+		}
+		hit.Score += best
+		if !slices.Contains(hit.Fields, bestField) {
+			hit.Fields = append(hit.Fields, bestField)
+		}
+	}
+	return hit, true
+}
+
+func matchScore(text, term string) float64 {
+	switch {
+	case text == term:
+		return exactMatch
+	case strings.HasPrefix(text, term):
+		return prefixMatch
+	case strings.Contains(text, term):
+		return substrMatch
+	}
+	return 0
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestIndex() *Index {
+	ix := NewIndex()
+	ix.Rebuild([]service.Member{
+		{Id: "1", Name: "田中 太郎", Nickname: "たなたろ", Roles: []string{"Web班", "副代表"}, Bio: "機械学習に興味があります"},
+		{Id: "2", Name: "佐藤 花子", Nickname: "ハナコ", Roles: []string{"ゲーム班"}, Bio: "Webアプリ開発が好き"},
+		{Id: "3", Name: "Suzuki Ichiro", Nickname: "いちろー", Roles: []string{"Web班"}, Status: service.StatusPending},
+	}, time.Now())
+	return ix
+}
+
+func ids(hits []Hit) []string {
+	out := make([]string, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, h.Member.Id)
+	}
+	return out
+}
+
+func TestIndexSearch(t *testing.T) {
+	ix := newTestIndex()
+
+	// 役職での一致は自己紹介での一致より上。同点は名前順
+	assert.Equal(t, []string{"3", "1", "2"}, ids(ix.Search("web", 10, nil)))
+	// カタカナ・ひらがな、全角・半角を区別しない
+	assert.Equal(t, []string{"2"}, ids(ix.Search("はなこ", 10, nil)))
+	assert.Equal(t, []string{"1"}, ids(ix.Search("ﾀﾅﾀﾛ", 10, nil)))
+	// ローマ字でかなのニックネームに一致する
+	assert.Equal(t, []string{"3"}, ids(ix.Search("ichiro", 10, nil)))
+	assert.Equal(t, []string{"2"}, ids(ix.Search("hanako", 10, nil)))
+	// 語の AND 検索
+	assert.Equal(t, []string{"1"}, ids(ix.Search("web 副代表", 10, nil)))
+	assert.Empty(t, ix.Search("web 会計", 10, nil))
+	assert.Empty(t, ix.Search("  ", 10, nil))
+
+	hits := ix.Search("田中", 10, nil)
+	assert.Equal(t, []string{FieldName}, hits[0].Fields)
+
+	// 見せないメンバーと件数の上限
+	active := func(m *service.Member) bool { return m.EffectiveStatus() == service.StatusActive }
+	assert.Equal(t, []string{"1", "2"}, ids(ix.Search("web", 10, active)))
+	assert.Len(t, ix.Search("web", 1, nil), 1)
+}
+
+func TestIndexNeedsRebuild(t *testing.T) {
+	ix := NewIndex()
+	now := time.Now()
+	assert.True(t, ix.NeedsRebuild(now, time.Minute))
+
+	ix.Rebuild(nil, now)
+	assert.False(t, ix.NeedsRebuild(now.Add(30*time.Second), time.Minute))
+	assert.True(t, ix.NeedsRebuild(now.Add(time.Minute), time.Minute))
+
+	ix.Invalidate()
+	assert.True(t, ix.NeedsRebuild(now, time.Minute))
+}
@@ -0,0 +1,137 @@
+// Package search はメンバー検索用のインメモリ索引を提供する。
+// 全角・半角、ひらがな・カタカナ、ローマ字の表記ゆれを吸収して名前・ニックネーム・役職・自己紹介を検索する。
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は検索用に文字列をそろえる。
+// NFKC（全角英数→半角、半角カナ→全角）→ 小文字化 → カタカナをひらがなに変換し、空白と中黒は取り除く。
+// 「タナカ　タロウ」「ﾀﾅｶ ﾀﾛｳ」「たなか・たろう」はどれも「たなかたろう」になる。
+func Normalize(s string) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r), r == '・':
+			continue
+		case r >= 'ァ' && r <= 'ヶ':
+			// カタカナ（ァ〜ヶ）は同じ並びのひらがな（ぁ〜ゖ）にずらす
+			b.WriteRune(r - 0x60)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// 拗音（きゃ など 2 文字）のローマ字。訓令式で持ち、ヘボン式の入力は canonicalRomaji でこちらに寄せる。
+var kanaDigraphs = map[string]string{
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo", "ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"しゃ": "sya", "しゅ": "syu", "しょ": "syo", "じゃ": "zya", "じゅ": "zyu", "じょ": "zyo",
+	"ちゃ": "tya", "ちゅ": "tyu", "ちょ": "tyo", "ぢゃ": "zya", "ぢゅ": "zyu", "ぢょ": "zyo",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo", "ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo", "ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo", "りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+	"しぇ": "sye", "じぇ": "zye", "ちぇ": "tye", "ふぁ": "hua", "ふぃ": "hui", "ふぇ": "hue", "ふぉ": "huo",
+	"てぃ": "ti", "でぃ": "di", "うぃ": "wi", "うぇ": "we", "ゔぁ": "ba", "ゔぃ": "bi", "ゔぇ": "be", "ゔぉ": "bo",
+}
+
+var kanaMonographs = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "si", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "zi", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "ti", 'つ': "tu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "zi", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "hu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n", 'ゔ': "bu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o", 'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+}
+
+// Romanize は Normalize 済みの文字列のかなをローマ字（訓令式）にし、canonicalRomaji で表記をそろえて返す。
+// かな以外（漢字など）は読みが分からないので落とす。かなを 1 文字も含まなければ空文字を返す。
+func Romanize(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	found := false
+	for i := 0; i < len(rs); i++ {
+		if i+1 < len(rs) {
+			if romaji, ok := kanaDigraphs[string(rs[i:i+2])]; ok {
+				b.WriteString(romaji)
+				found = true
+				i++
+				continue
+			}
+		}
+		switch r := rs[i]; {
+		case r == 'っ':
+			// 促音は次の子音を重ねる（「った」→ tta）
+			if i+1 < len(rs) {
+				if next, ok := kanaMonographs[rs[i+1]]; ok && next != "" && !strings.ContainsRune("aiueon", rune(next[0])) {
+					b.WriteByte(next[0])
+				}
+			}
+			found = true
+		case r == 'ー':
+			// 長音は直前の母音を重ねる（canonicalRomaji で 1 つにまとめる）
+			if str := b.String(); str != "" {
+				b.WriteByte(str[len(str)-1])
+			}
+			found = true
+		default:
+			if romaji, ok := kanaMonographs[r]; ok {
+				b.WriteString(romaji)
+				found = true
+			} else if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+				b.WriteRune(r)
+			}
+		}
+	}
+	if !found {
+		return ""
+	}
+	return canonicalRomaji(b.String())
+}
+
+// ヘボン式などの表記を訓令式に寄せ、長音の書き方の違いをなくす置き換え（上から順に適用する）。
+var romajiReplacer = []struct{ from, to string }{
+	{"shi", "si"}, {"sh", "sy"}, {"chi", "ti"}, {"tch", "tt"}, {"ch", "ty"}, {"tsu", "tu"},
+	{"fu", "hu"}, {"ji", "zi"}, {"j", "zy"}, {"di", "zi"}, {"du", "zu"},
+	{"mb", "nb"}, {"mp", "np"}, {"mm", "nm"}, {"n'", "n"},
+	{"oh", "o"}, {"ou", "o"}, {"oo", "o"}, {"uu", "u"}, {"aa", "a"}, {"ii", "i"}, {"ee", "e"},
+}
+
+// canonicalRomaji はローマ字の表記ゆれ（shi/si、tsu/tu、ou/oo/o など）をそろえる。
+// 「satou」「satoh」「sato」「satoo」はどれも「sato」になる。
+func canonicalRomaji(s string) string {
+	for _, r := range romajiReplacer {
+		s = strings.ReplaceAll(s, r.from, r.to)
+	}
+	return s
+}
+
+// isASCIIWord は s が英数字だけでできているか（ローマ字での検索として扱えるか）を返す。
+func isASCIIWord(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r >= unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'') {
+			return false
+		}
+	}
+	return true
+}
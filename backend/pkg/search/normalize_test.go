@@ -0,0 +1,31 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "たなかたろう", Normalize("タナカ　タロウ"))
+	assert.Equal(t, "たなかたろう", Normalize("ﾀﾅｶ ﾀﾛｳ"))
+	assert.Equal(t, "たなかたろう", Normalize("たなか・たろう"))
+	assert.Equal(t, "web班", Normalize("ＷＥＢ班"))
+	assert.Equal(t, "田中太郎", Normalize("田中 太郎"))
+}
+
+func TestRomanize(t *testing.T) {
+	assert.Equal(t, "tanakataro", Romanize(Normalize("たなかたろう")))
+	assert.Equal(t, "syota", Romanize(Normalize("しょうた")))
+	assert.Equal(t, "kitte", Romanize(Normalize("キッテ")))
+	assert.Equal(t, "ramen", Romanize(Normalize("ラーメン")))
+	// 漢字だけなら読めないので空
+	assert.Equal(t, "", Romanize("田中"))
+
+	// ヘボン式・長音の書き方の違いは同じ形になる
+	for _, q := range []string{"satou", "satoh", "sato", "satoo"} {
+		assert.Equal(t, Romanize("さとう"), canonicalRomaji(q), q)
+	}
+	assert.Equal(t, Romanize("しんいち"), canonicalRomaji("shinichi"))
+	assert.Equal(t, Romanize("つじ"), canonicalRomaji("tsuji"))
+}
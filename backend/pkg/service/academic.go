@@ -0,0 +1,69 @@
+package service
+
+import (
+	"strconv"
+	"time"
+)
+
+// jst は学年度の切り替え判定に使うタイムゾーン（4/1 0:00 JST で新年度）。
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// DefaultProgramYears は修業年限が未設定のメンバーに使う年数（学部 4 年）。
+const DefaultProgramYears = 4
+
+// AcademicYear は t が属する年度を返す（4 月始まり。2025/3/31 は 2024 年度）。
+func AcademicYear(t time.Time) int {
+	t = t.In(jst)
+	if t.Month() < time.April {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// ProgramYearsOrDefault は修業年限を返す。未設定なら DefaultProgramYears。
+func (m *Member) ProgramYearsOrDefault() int {
+	if m.ProgramYears > 0 {
+		return m.ProgramYears
+	}
+	return DefaultProgramYears
+}
+
+// GradeAt は now 時点の学年（入学年度が 1 年生）を返す。入学年度が未設定なら 0。
+// 修業年限を超えても（留年など）そのまま数える。
+func (m *Member) GradeAt(now time.Time) int {
+	if m.EnrollmentYear == 0 {
+		return 0
+	}
+	return max(AcademicYear(now)-m.EnrollmentYear+1, 1)
+}
+
+// GraduatedAt は now 時点で修業年限を終えているか（卒業年度の 3 月が過ぎたか）を返す。
+func (m *Member) GraduatedAt(now time.Time) bool {
+	return m.EnrollmentYear != 0 && m.GradeAt(now) > m.ProgramYearsOrDefault()
+}
+
+// DisplayYear は表示用の学年を返す。入学年度があれば「2年生」のように自動で計算し、
+// なければ以前の自由入力の year をそのまま返す。
+func (m *Member) DisplayYear(now time.Time) string {
+	if m.EffectiveStatus() == StatusAlumni {
+		if m.EnrollmentYear != 0 {
+			// 卒業年度は修業年限の最終年度（2023 年度入学の 4 年制なら 2026 年度卒）
+			return strconv.Itoa(m.EnrollmentYear+m.ProgramYearsOrDefault()-1) + "年度卒"
+		}
+		return "卒業生"
+	}
+	if grade := m.GradeAt(now); grade > 0 {
+		return strconv.Itoa(grade) + "年生"
+	}
+	return m.Year
+}
+
+// AcademicYearSpan は year 年度の初日（4/1）と最終日（翌 3/31）を JST の 0:00 で返す。
+func AcademicYearSpan(year int) (first, last time.Time) {
+	return time.Date(year, time.April, 1, 0, 0, 0, 0, jst), time.Date(year+1, time.March, 31, 0, 0, 0, 0, jst)
+}
+
+// DateInJST は t の年月日を JST の 0:00 として返す（API の日付を保存するときに使う）。
+func DateInJST(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcademicYear(t *testing.T) {
+	assert.Equal(t, 2024, AcademicYear(time.Date(2025, 3, 31, 23, 59, 0, 0, jst)))
+	assert.Equal(t, 2025, AcademicYear(time.Date(2025, 4, 1, 0, 0, 0, 0, jst)))
+	// 3/31 15:00 UTC は JST で 4/1 0:00
+	assert.Equal(t, 2025, AcademicYear(time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)))
+}
+
+func TestMemberGrade(t *testing.T) {
+	m := Member{EnrollmentYear: 2023, Year: "1年生"}
+	april2025 := time.Date(2025, 4, 1, 9, 0, 0, 0, jst)
+
+	assert.Equal(t, 3, m.GradeAt(april2025))
+	assert.Equal(t, "3年生", m.DisplayYear(april2025))
+	assert.Equal(t, "2年生", m.DisplayYear(april2025.AddDate(0, 0, -1)))
+
+	// 4 年制は 2027/4/1 に卒業扱い
+	assert.False(t, m.GraduatedAt(time.Date(2027, 3, 31, 0, 0, 0, 0, jst)))
+	assert.True(t, m.GraduatedAt(time.Date(2027, 4, 1, 0, 0, 0, 0, jst)))
+
+	// 修士 2 年
+	m.ProgramYears = 2
+	assert.True(t, m.GraduatedAt(time.Date(2025, 4, 1, 0, 0, 0, 0, jst)))
+
+	m.Status = StatusAlumni
+	assert.Equal(t, "2024年度卒", m.DisplayYear(april2025))
+
+	// 入学年度がなければ自由入力の year のまま
+	legacy := Member{Year: "2年生"}
+	assert.Equal(t, 0, legacy.GradeAt(april2025))
+	assert.Equal(t, "2年生", legacy.DisplayYear(april2025))
+	assert.False(t, legacy.GraduatedAt(april2025))
+}
+
+func TestToDetail_Grade(t *testing.T) {
+	enrollment := AcademicYear(time.Now()) - 1
+	m := Member{Id: "m1", EnrollmentYear: enrollment, Year: "1年生"}
+	detail := m.ToDetail()
+	assert.Equal(t, "2年生", detail.Year)
+	if assert.NotNil(t, detail.Grade) {
+		assert.Equal(t, 2, *detail.Grade)
+	}
+	assert.Equal(t, 4, *detail.ProgramYears)
+
+	m.Status = StatusAlumni
+	detail = m.ToDetail()
+	assert.Nil(t, detail.Grade)
+	assert.Equal(t, strconv.Itoa(enrollment+3)+"年度卒", detail.Year)
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrMemberDeleted はメンバーが既に削除済みであることを表す。
+	ErrMemberDeleted = errors.New("member is already deleted")
+	// ErrMemberNotDeleted は復元しようとしたメンバーが削除されていないことを表す。
+	ErrMemberNotDeleted = errors.New("member is not deleted")
+)
+
+// Delete はメンバーを削除済み（deleted_at に現在時刻を入れる）にする。ドキュメントや任期は消さないので Restore で戻せる。
+// 削除済みなら ErrMemberDeleted、統合済みなら ErrAlreadyMerged。
+func (s *MembersService) Delete(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.Delete", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(membersCollection).Doc(id)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		m, err := getMemberTx(tx, ref)
+		if err != nil {
+			return err
+		}
+		switch {
+		case m.MergedInto != "":
+			return ErrAlreadyMerged
+		case m.Deleted():
+			return ErrMemberDeleted
+		}
+		if err := tx.Update(ref, []firestore.Update{{Path: "deleted_at", Value: time.Now()}}); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		return nil
+	}))
+}
+
+// Restore は削除済みのメンバーを元に戻し、戻したメンバーを返す。削除されていなければ ErrMemberNotDeleted。
+// 削除している間に同じ学籍番号・LINE ユーザーID で別のメンバーが登録されていれば ErrStudentIDTaken / ErrLineUserIDTaken。
+func (s *MembersService) Restore(ctx context.Context, id string) (*Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.Restore", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(membersCollection).Doc(id)
+	var restored *Member
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		m, err := getMemberTx(tx, ref)
+		if err != nil {
+			return err
+		}
+		if !m.Deleted() {
+			return ErrMemberNotDeleted
+		}
+		if err := checkMemberUnique(s.fs, tx, *m); err != nil {
+			return err
+		}
+		if err := tx.Update(ref, []firestore.Update{{Path: "deleted_at", Value: firestore.Delete}}); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		m.DeletedAt = time.Time{}
+		restored = m
+		return nil
+	})
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	return restored, nil
+}
+
+// ListDeleted は削除済みのメンバーを削除が新しい順に返す。
+func (s *MembersService) ListDeleted(ctx context.Context) ([]Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.ListDeleted")
+	defer span.End()
+
+	// deleted_at のないドキュメントはこの条件に当たらない
+	docs, err := s.fs.Collection(membersCollection).Where("deleted_at", ">", time.Time{}).Documents(ctx).GetAll()
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(membersCollection, len(docs))
+
+	out := make([]Member, 0, len(docs))
+	for _, doc := range docs {
+		var m Member
+		if err := doc.DataTo(&m); err != nil {
+			logging.FromContext(ctx).Warn("failed to parse document into Member, skip", "doc", doc.Ref.ID, "error", err)
+			metrics.MalformedMemberSkipped()
+			continue
+		}
+		if m.Id == "" {
+			m.Id = doc.Ref.ID
+		}
+		out = append(out, m)
+	}
+	slices.SortStableFunc(out, func(a, b Member) int { return b.DeletedAt.Compare(a.DeletedAt) })
+	span.SetAttributes(attribute.Int("members.count", len(out)))
+	return out, nil
+}
+
+// PurgeDeleted は before より前に削除されたメンバーを、ログイン ID（member_identities）ごと完全に削除する。
+// 役職の任期（role_terms）は歴代の記録として残し、名前を ErasedMemberName に置き換える。
+// dryRun の場合は書き込まずに対象だけを返す。
+// 書き込みは BulkWriter でまとめて行い、失敗したらそれまでに消し終えたメンバーとエラーを返す。
+func (s *MembersService) PurgeDeleted(ctx context.Context, before time.Time, dryRun bool) ([]Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.PurgeDeleted", trace.WithAttributes(
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	deleted, err := s.ListDeleted(ctx)
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	targets := make([]Member, 0)
+	for _, m := range deleted {
+		if m.DeletedAt.Before(before) {
+			targets = append(targets, m)
+		}
+	}
+	span.SetAttributes(attribute.Int("members.count", len(targets)))
+	if dryRun || len(targets) == 0 {
+		return targets, nil
+	}
+
+	for i, m := range targets {
+		if err := s.purge(ctx, m.Id); err != nil {
+			return targets[:i], endSpan(span, fmt.Errorf("purge %s: %w", m.Id, err))
+		}
+	}
+	return targets, nil
+}
+
+// purge はメンバー 1 人のドキュメントとログイン ID を消し、任期の名前を置き換える。
+func (s *MembersService) purge(ctx context.Context, id string) error {
+	query := func(col string) ([]*firestore.DocumentSnapshot, error) {
+		docs, err := s.fs.Collection(col).Where("member_id", "==", id).Documents(ctx).GetAll()
+		if err != nil {
+			metrics.FirestoreError(col, "read")
+			return nil, err
+		}
+		metrics.FirestoreRead(col, len(docs))
+		return docs, nil
+	}
+	identities, err := query(identitiesCollection)
+	if err != nil {
+		return err
+	}
+	terms, err := query(roleTermsCollection)
+	if err != nil {
+		return err
+	}
+
+	bw := s.fs.BulkWriter(ctx)
+	type write struct {
+		col string
+		job *firestore.BulkWriterJob
+	}
+	jobs := make([]write, 0, 1+len(identities)+len(terms))
+	add := func(col string, job *firestore.BulkWriterJob, err error) error {
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, write{col: col, job: job})
+		return nil
+	}
+	job, err := bw.Delete(s.fs.Collection(membersCollection).Doc(id))
+	if err := add(membersCollection, job, err); err != nil {
+		return err
+	}
+	for _, d := range identities {
+		job, err := bw.Delete(d.Ref)
+		if err := add(identitiesCollection, job, err); err != nil {
+			return err
+		}
+	}
+	for _, d := range terms {
+		job, err := bw.Update(d.Ref, []firestore.Update{{Path: "member_name", Value: ErasedMemberName}})
+		if err := add(roleTermsCollection, job, err); err != nil {
+			return err
+		}
+	}
+	bw.End()
+
+	for _, w := range jobs {
+		if _, err := w.job.Results(); err != nil {
+			metrics.FirestoreError(w.col, "write")
+			return err
+		}
+		metrics.FirestoreWrite(w.col, 1)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"time"
+
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Event はメンバーが参加する/参加したイベント。members ドキュメントの events に保存されている。
+// 以前は詳細 API が api.MemberDetail に直接デコードして返していたので、Member 経由でも同じ内容を返せるように持つ。
+type Event struct {
+	Name   string    `firestore:"name"`
+	Date   time.Time `firestore:"date"`
+	Status string    `firestore:"status"` // upcoming / completed
+}
+
+// eventsToAPI は events を MemberDetail の events に変換する。空でも null ではなく [] を返す。
+func eventsToAPI(events []Event) []struct {
+	Date   openapi_types.Date           `json:"date"`
+	Name   string                       `json:"name"`
+	Status api.MemberDetailEventsStatus `json:"status"`
+} {
+	out := make([]struct {
+		Date   openapi_types.Date           `json:"date"`
+		Name   string                       `json:"name"`
+		Status api.MemberDetailEventsStatus `json:"status"`
+	}, 0, len(events))
+	for _, e := range events {
+		out = append(out, struct {
+			Date   openapi_types.Date           `json:"date"`
+			Name   string                       `json:"name"`
+			Status api.MemberDetailEventsStatus `json:"status"`
+		}{Date: openapi_types.Date{Time: e.Date}, Name: e.Name, Status: api.MemberDetailEventsStatus(e.Status)})
+	}
+	return out
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const identitiesCollection = "member_identities"
+
+var (
+	// ErrIdentityNotFound は (provider, subject) に紐づくメンバーがいないことを表す。
+	ErrIdentityNotFound = errors.New("identity not found")
+	// ErrIdentityAlreadyLinked はログイン ID が別のメンバーに紐づいていることを表す。
+	ErrIdentityAlreadyLinked = errors.New("identity is already linked to another member")
+)
+
+// Identity は外部 ID プロバイダのユーザー（provider, subject）とメンバーの対応。
+// 1 人のメンバーが複数のプロバイダでログインできる。
+// JSON は本人へのデータの書き出し（GET /api/me/export）に使う。
+type Identity struct {
+	Provider    string    `firestore:"provider" json:"provider"`
+	Subject     string    `firestore:"subject" json:"subject"`
+	MemberID    string    `firestore:"member_id" json:"member_id"`
+	Email       string    `firestore:"email,omitempty" json:"email,omitempty"`
+	CreatedAt   time.Time `firestore:"created_at" json:"created_at"`
+	LastLoginAt time.Time `firestore:"last_login_at" json:"last_login_at"`
+}
+
+// IdentitiesService は Firestore の "member_identities" コレクションに対する操作を提供する。
+// ドキュメント ID は provider と subject から決まるので、同じ ID が二重に登録されることはない。
+type IdentitiesService struct {
+	fs *firestore.Client
+}
+
+// NewIdentitiesService は IdentitiesService を生成する。
+func NewIdentitiesService(fs *firestore.Client) *IdentitiesService {
+	return &IdentitiesService{fs: fs}
+}
+
+// identityDocID は (provider, subject) のドキュメント ID を返す。subject に "/" が含まれても壊れないようエスケープする。
+func identityDocID(provider, subject string) string {
+	return provider + ":" + url.PathEscape(subject)
+}
+
+// Resolve は (provider, subject) に紐づく Identity を返す。見つからなければ ErrIdentityNotFound。
+func (s *IdentitiesService) Resolve(ctx context.Context, provider, subject string) (*Identity, error) {
+	ctx, span := tracer.Start(ctx, "IdentitiesService.Resolve", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	doc, err := s.fs.Collection(identitiesCollection).Doc(identityDocID(provider, subject)).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrIdentityNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(identitiesCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(identitiesCollection, 1)
+
+	var id Identity
+	if err := doc.DataTo(&id); err != nil {
+		return nil, endSpan(span, err)
+	}
+	return &id, nil
+}
+
+// Link は (provider, subject) をメンバーに紐づける。別のメンバーに紐づいていれば ErrIdentityAlreadyLinked。
+// 同じメンバーに紐づけ済みなら何もしない。
+// LINE の場合はメンバーの line_user_id も書き込み、別のメンバーが同じ LINE ユーザーID を持っていれば ErrLineUserIDTaken。
+func (s *IdentitiesService) Link(ctx context.Context, provider, subject, memberID, email string) error {
+	ctx, span := tracer.Start(ctx, "IdentitiesService.Link", trace.WithAttributes(
+		attribute.String("provider", provider),
+		attribute.String("member.id", memberID),
+	))
+	defer span.End()
+
+	ref := s.fs.Collection(identitiesCollection).Doc(identityDocID(provider, subject))
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		switch {
+		case status.Code(err) == codes.NotFound:
+		case err != nil:
+			metrics.FirestoreError(identitiesCollection, "read")
+			return err
+		default:
+			metrics.FirestoreRead(identitiesCollection, 1)
+			var existing Identity
+			if err := doc.DataTo(&existing); err != nil {
+				return err
+			}
+			if existing.MemberID != memberID {
+				return ErrIdentityAlreadyLinked
+			}
+			return nil
+		}
+
+		memberRef := s.fs.Collection(membersCollection).Doc(memberID)
+		if provider == ProviderLINE {
+			if err := checkMemberUnique(s.fs, tx, Member{Id: memberID, LineUserID: subject}); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		if err := tx.Create(ref, Identity{
+			Provider:    provider,
+			Subject:     subject,
+			MemberID:    memberID,
+			Email:       email,
+			CreatedAt:   now,
+			LastLoginAt: now,
+		}); err != nil {
+			metrics.FirestoreError(identitiesCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(identitiesCollection, 1)
+		if provider != ProviderLINE {
+			return nil
+		}
+		if err := tx.Update(memberRef, []firestore.Update{{Path: "line_user_id", Value: subject}}); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		return nil
+	}))
+}
+
+// RecordLogin は最終ログイン日時と、プロバイダから取れたメールアドレスを更新する。
+func (s *IdentitiesService) RecordLogin(ctx context.Context, provider, subject, email string) error {
+	ctx, span := tracer.Start(ctx, "IdentitiesService.RecordLogin", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	updates := []firestore.Update{{Path: "last_login_at", Value: time.Now()}}
+	if email != "" {
+		updates = append(updates, firestore.Update{Path: "email", Value: email})
+	}
+	_, err := s.fs.Collection(identitiesCollection).Doc(identityDocID(provider, subject)).Update(ctx, updates)
+	if status.Code(err) == codes.NotFound {
+		return ErrIdentityNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(identitiesCollection, "write")
+		return endSpan(span, err)
+	}
+	metrics.FirestoreWrite(identitiesCollection, 1)
+	return nil
+}
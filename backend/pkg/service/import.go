@@ -0,0 +1,236 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxImportRows は 1 回の CSV インポートで受け付ける行数（見出し行を除く）。
+const MaxImportRows = 500
+
+// ErrInvalidCSV は CSV として読めない、見出し行に必要な列がない、行数が多すぎることを表す。
+var ErrInvalidCSV = errors.New("invalid csv")
+
+// CSV の列。見出しは英語・日本語のどちらでもよい（NFKC・小文字化してから比べる）。
+const (
+	importColName      = "name"
+	importColNickname  = "nickname"
+	importColStudentID = "student_id"
+	importColDept      = "department"
+	importColYear      = "year"
+	importColRoles     = "roles"
+)
+
+var importHeaders = map[string]string{
+	"name":       importColName,
+	"名前":         importColName,
+	"氏名":         importColName,
+	"nickname":   importColNickname,
+	"ニックネーム":     importColNickname,
+	"student_id": importColStudentID,
+	"student id": importColStudentID,
+	"学籍番号":       importColStudentID,
+	"department": importColDept,
+	"学部":         importColDept,
+	"学部・学科":      importColDept,
+	"year":       importColYear,
+	"学年":         importColYear,
+	"roles":      importColRoles,
+	"役職":         importColRoles,
+	"班・役職":       importColRoles,
+}
+
+var studentIDPattern = regexp.MustCompile(`^[A-Z0-9-]{4,20}$`)
+
+// ImportRow は CSV の 1 行を確認した結果。
+type ImportRow struct {
+	// Line は CSV の行番号（見出し行が 1）
+	Line   int
+	Member Member
+	// RoleRefs は CSV に書かれた班・役職の名前か ID（ResolveTeams で Member に設定する）
+	RoleRefs []string
+	Errors   []string
+}
+
+// Valid はエラーがなく登録できる行かを返す。
+func (r *ImportRow) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// AddError は行にエラーを追加する。
+func (r *ImportRow) AddError(format string, args ...any) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+// NormalizeStudentID は学籍番号の表記をそろえる（全角→半角、英字は大文字、前後の空白を除く）。
+func NormalizeStudentID(id string) string {
+	return strings.ToUpper(strings.TrimSpace(norm.NFKC.String(id)))
+}
+
+// ValidStudentID は学籍番号（NormalizeStudentID 済み）が英大文字・数字・ハイフンの 4〜20 文字かを返す。
+func ValidStudentID(id string) bool {
+	return studentIDPattern.MatchString(id)
+}
+
+// ParseImportCSV はメンバー一括登録用の CSV を読み、行ごとに Member へ変換して確認する。
+// 行の内容の誤りは ImportRow.Errors に記録し、CSV 全体が読めないときだけ ErrInvalidCSV を返す。
+// 学籍番号の重複と班・役職の解決はここでは確認しない（CheckImportDuplicates と ResolveTeams を使う）。
+// 学年の列は学年（1〜9、「2年生」も可）か入学年度（2024 など）で、now の年度から入学年度を決める。
+func ParseImportCSV(r io.Reader, now time.Time) ([]ImportRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1 // 列数の違いは行のエラーとして扱う
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidCSV)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCSV, err)
+	}
+	// Excel で保存した CSV は先頭に BOM が付く
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		key, ok := importHeaders[strings.ToLower(strings.TrimSpace(norm.NFKC.String(h)))]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidCSV, h)
+		}
+		if _, dup := cols[key]; dup {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidCSV, h)
+		}
+		cols[key] = i
+	}
+	for _, required := range []string{importColName, importColStudentID} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("%w: column %s is required", ErrInvalidCSV, required)
+		}
+	}
+
+	rows := make([]ImportRow, 0)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCSV, err)
+		}
+		line, _ := cr.FieldPos(0)
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) == MaxImportRows {
+			return nil, fmt.Errorf("%w: at most %d rows", ErrInvalidCSV, MaxImportRows)
+		}
+		rows = append(rows, parseImportRecord(line, record, cols, len(header), now))
+	}
+	return rows, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseImportRecord(line int, record []string, cols map[string]int, width int, now time.Time) ImportRow {
+	row := ImportRow{Line: line}
+	if len(record) != width {
+		row.AddError("expected %d fields, got %d", width, len(record))
+		return row
+	}
+	get := func(col string) string {
+		i, ok := cols[col]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	m := Member{
+		Name:       get(importColName),
+		Nickname:   get(importColNickname),
+		StudentID:  NormalizeStudentID(get(importColStudentID)),
+		Department: get(importColDept),
+		Status:     StatusActive,
+	}
+	if m.Name == "" {
+		row.AddError("name is required")
+	}
+	switch {
+	case m.StudentID == "":
+		row.AddError("student_id is required")
+	case !ValidStudentID(m.StudentID):
+		row.AddError("student_id %q must be 4 to 20 letters, digits or hyphens", m.StudentID)
+	}
+	if y := get(importColYear); y != "" {
+		enrollment, err := parseImportYear(y, now)
+		if err != nil {
+			row.AddError("%v", err)
+		}
+		m.EnrollmentYear = enrollment
+	}
+	for _, ref := range strings.FieldsFunc(norm.NFKC.String(get(importColRoles)), func(r rune) bool { return r == ';' || r == '、' }) {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			row.RoleRefs = append(row.RoleRefs, ref)
+		}
+	}
+	row.Member = m
+	return row
+}
+
+// parseImportYear は学年（1〜9、「2年」「2年生」）か入学年度（2000〜来年度）を入学年度にして返す。
+func parseImportYear(s string, now time.Time) (int, error) {
+	t := strings.TrimSpace(norm.NFKC.String(s))
+	t = strings.TrimSuffix(strings.TrimSuffix(t, "生"), "年")
+	n, err := strconv.Atoi(t)
+	current := AcademicYear(now)
+	switch {
+	case err != nil:
+	case n >= 1 && n <= 9:
+		return current - n + 1, nil
+	case n >= 2000 && n <= current+1:
+		return n, nil
+	}
+	return 0, fmt.Errorf("year %q must be a grade (1-9) or an enrollment year (2000-%d)", s, current+1)
+}
+
+// CheckImportDuplicates は登録済みのメンバーや CSV 内の前の行と学籍番号が重なる行にエラーを付ける。
+func CheckImportDuplicates(rows []ImportRow, existing []Member) {
+	registered := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		if m.StudentID != "" {
+			registered[m.StudentID] = true
+		}
+	}
+	firstLine := map[string]int{}
+	for i := range rows {
+		id := rows[i].Member.StudentID
+		if id == "" {
+			continue
+		}
+		if registered[id] {
+			rows[i].AddError("student_id %s is already registered", id)
+			continue
+		}
+		if line, ok := firstLine[id]; ok {
+			rows[i].AddError("student_id %s is duplicated on line %d", id, line)
+			continue
+		}
+		firstLine[id] = rows[i].Line
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	now := time.Date(2025, time.May, 1, 0, 0, 0, 0, jst)
+	csv := "\ufeff名前,ニックネーム,学籍番号,学部,学年,役職\n" +
+		"田中 太郎,たなたろ,２４ｔ０００１,情報工学部,1年生,Web班;代表\n" +
+		",,24T0002,,2,\n" +
+		"\n" +
+		"鈴木 花子,はな,x,理学部,2019,\n" +
+		"佐藤 次郎,じろ,23T0003,工学部,十,\n" +
+		"山田,\n"
+
+	rows, err := ParseImportCSV(strings.NewReader(csv), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 5 {
+		t.Fatalf("expected 5 rows (blank lines skipped), got %d", len(rows))
+	}
+
+	first := rows[0]
+	if !first.Valid() || first.Line != 2 {
+		t.Fatalf("unexpected first row: %+v", first)
+	}
+	if first.Member.StudentID != "24T0001" || first.Member.EnrollmentYear != 2025 || first.Member.Status != StatusActive {
+		t.Fatalf("unexpected member: %+v", first.Member)
+	}
+	if len(first.RoleRefs) != 2 || first.RoleRefs[0] != "Web班" || first.RoleRefs[1] != "代表" {
+		t.Fatalf("unexpected roles: %v", first.RoleRefs)
+	}
+	if rows[1].Valid() || rows[1].Errors[0] != "name is required" {
+		t.Fatalf("missing name should be an error: %+v", rows[1])
+	}
+	if rows[2].Line != 5 || len(rows[2].Errors) != 1 || !strings.Contains(rows[2].Errors[0], "student_id") {
+		t.Fatalf("short student id should be an error: %+v", rows[2])
+	}
+	if rows[2].Member.EnrollmentYear != 2019 {
+		t.Fatalf("enrollment years should be kept: %+v", rows[2].Member)
+	}
+	if rows[3].Valid() || !strings.Contains(rows[3].Errors[0], "year") {
+		t.Fatalf("invalid year should be an error: %+v", rows[3])
+	}
+	if rows[4].Valid() || !strings.Contains(rows[4].Errors[0], "fields") {
+		t.Fatalf("short records should be an error: %+v", rows[4])
+	}
+}
+
+func TestParseImportCSV_InvalidFile(t *testing.T) {
+	for name, csv := range map[string]string{
+		"empty":            "",
+		"unknown column":   "name,student_id,email\n",
+		"missing required": "name,nickname\n",
+		"duplicate column": "name,名前,student_id\n",
+		"broken quote":     "name,student_id\n\"田中,24T0001\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseImportCSV(strings.NewReader(csv), time.Now()); !errors.Is(err, ErrInvalidCSV) {
+				t.Fatalf("expected ErrInvalidCSV, got %v", err)
+			}
+		})
+	}
+
+	var b strings.Builder
+	b.WriteString("name,student_id\n")
+	for range MaxImportRows + 1 {
+		b.WriteString("田中,24T0001\n")
+	}
+	if _, err := ParseImportCSV(strings.NewReader(b.String()), time.Now()); !errors.Is(err, ErrInvalidCSV) {
+		t.Fatalf("expected ErrInvalidCSV for too many rows, got %v", err)
+	}
+}
+
+func TestCheckImportDuplicates(t *testing.T) {
+	rows := []ImportRow{
+		{Line: 2, Member: Member{StudentID: "24T0001"}},
+		{Line: 3, Member: Member{StudentID: "24T0002"}},
+		{Line: 4, Member: Member{StudentID: "24T0001"}},
+		{Line: 5, Member: Member{StudentID: "23T0009"}},
+	}
+	CheckImportDuplicates(rows, []Member{{Id: "m1", StudentID: "23T0009"}, {Id: "m2"}})
+
+	if !rows[0].Valid() || !rows[1].Valid() {
+		t.Fatalf("first occurrences should be valid: %+v", rows[:2])
+	}
+	if rows[2].Valid() || !strings.Contains(rows[2].Errors[0], "line 2") {
+		t.Fatalf("duplicate within the file should point at the first line: %+v", rows[2])
+	}
+	if rows[3].Valid() || !strings.Contains(rows[3].Errors[0], "already registered") {
+		t.Fatalf("registered student id should be an error: %+v", rows[3])
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const invitationsCollection = "invitations"
+
+// 招待コードの状態
+const (
+	InvitationOutstanding = "outstanding" // まだ使える
+	InvitationRedeemed    = "redeemed"    // 使用回数の上限に達した
+	InvitationExpired     = "expired"     // 期限切れ
+)
+
+const (
+	// 読み間違えやすい 0/O・1/I を除いた英数字
+	invitationCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	invitationCodeLength   = 10
+)
+
+// ErrInvitationInvalid は招待コードが存在しない・期限切れ・使用済みであることを表す。
+var ErrInvitationInvalid = errors.New("invitation code is invalid, expired or already used")
+
+// Invitation は役員が発行する新メンバー用の招待コード。
+// RoleIDs / Roles / Year が設定されていれば、登録時にリクエストの値より優先する。
+type Invitation struct {
+	Code        string       `firestore:"code"`
+	MaxUses     int          `firestore:"max_uses"`
+	Uses        int          `firestore:"uses"`
+	ExpiresAt   time.Time    `firestore:"expires_at"`
+	RoleIDs     []string     `firestore:"role_ids,omitempty"`
+	Roles       []string     `firestore:"roles"`
+	Year        string       `firestore:"year,omitempty"`
+	Note        string       `firestore:"note,omitempty"`
+	CreatedBy   string       `firestore:"created_by"`
+	CreatedAt   time.Time    `firestore:"created_at"`
+	Redemptions []Redemption `firestore:"redemptions"`
+}
+
+// Redemption は招待コードを使って登録したメンバーの記録。
+type Redemption struct {
+	MemberID   string    `firestore:"member_id"`
+	RedeemedAt time.Time `firestore:"redeemed_at"`
+}
+
+// Status は now 時点での招待コードの状態を返す。
+func (inv *Invitation) Status(now time.Time) string {
+	switch {
+	case inv.Uses >= inv.MaxUses:
+		return InvitationRedeemed
+	case !now.Before(inv.ExpiresAt):
+		return InvitationExpired
+	default:
+		return InvitationOutstanding
+	}
+}
+
+// NormalizeInvitationCode は入力された招待コードから空白・ハイフンを除いて大文字にそろえる。
+func NormalizeInvitationCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '　' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// InvitationsService は Firestore の "invitations" コレクションに対する操作を提供する。
+type InvitationsService struct {
+	fs *firestore.Client
+}
+
+// NewInvitationsService は InvitationsService を生成する。
+func NewInvitationsService(fs *firestore.Client) *InvitationsService {
+	return &InvitationsService{fs: fs}
+}
+
+// Create は招待コードを発行して保存する。Code / Uses / CreatedAt はこちらで埋める。
+func (s *InvitationsService) Create(ctx context.Context, inv Invitation) (*Invitation, error) {
+	ctx, span := tracer.Start(ctx, "InvitationsService.Create")
+	defer span.End()
+
+	inv.Uses = 0
+	inv.CreatedAt = time.Now()
+	if inv.Roles == nil {
+		inv.Roles = []string{}
+	}
+	if inv.Redemptions == nil {
+		inv.Redemptions = []Redemption{}
+	}
+	// コードの衝突はまず起きないが、起きたら作り直す
+	for range 3 {
+		code, err := newInvitationCode()
+		if err != nil {
+			return nil, endSpan(span, err)
+		}
+		inv.Code = code
+		_, err = s.fs.Collection(invitationsCollection).Doc(code).Create(ctx, inv)
+		if status.Code(err) == codes.AlreadyExists {
+			continue
+		}
+		if err != nil {
+			metrics.FirestoreError(invitationsCollection, "write")
+			return nil, endSpan(span, err)
+		}
+		metrics.FirestoreWrite(invitationsCollection, 1)
+		return &inv, nil
+	}
+	return nil, endSpan(span, errors.New("could not allocate a unique invitation code"))
+}
+
+// List は招待コードを新しい順に返す。
+func (s *InvitationsService) List(ctx context.Context) ([]Invitation, error) {
+	ctx, span := tracer.Start(ctx, "InvitationsService.List")
+	defer span.End()
+
+	iter := s.fs.Collection(invitationsCollection).Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]Invitation, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			metrics.FirestoreError(invitationsCollection, "read")
+			return nil, endSpan(span, err)
+		}
+		metrics.FirestoreRead(invitationsCollection, 1)
+
+		var inv Invitation
+		if err := doc.DataTo(&inv); err != nil {
+			return nil, endSpan(span, fmt.Errorf("invitation %s: %w", doc.Ref.ID, err))
+		}
+		out = append(out, inv)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+// Redeem は招待コードを 1 回分消費して新しいメンバーを登録し、ログインに使った (provider, subject) を紐づける。
+// コードの検証・メンバー作成・member_identities の作成は 1 つのトランザクションで行う。
+// 学籍番号・LINE ユーザーID が登録済みのメンバーと同じなら ErrStudentIDTaken / ErrLineUserIDTaken。
+func (s *InvitationsService) Redeem(ctx context.Context, code string, m Member, provider, subject string) (string, error) {
+	ctx, span := tracer.Start(ctx, "InvitationsService.Redeem", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	invRef := s.fs.Collection(invitationsCollection).Doc(NormalizeInvitationCode(code))
+	idRef := s.fs.Collection(identitiesCollection).Doc(identityDocID(provider, subject))
+	memberRef := s.fs.Collection(membersCollection).NewDoc()
+
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// --- ① 招待コードが使えるか確認 ---
+		doc, err := tx.Get(invRef)
+		if status.Code(err) == codes.NotFound {
+			return ErrInvitationInvalid
+		}
+		if err != nil {
+			metrics.FirestoreError(invitationsCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(invitationsCollection, 1)
+		var inv Invitation
+		if err := doc.DataTo(&inv); err != nil {
+			return err
+		}
+		now := time.Now()
+		if st := inv.Status(now); st != InvitationOutstanding {
+			return fmt.Errorf("%w: %s", ErrInvitationInvalid, st)
+		}
+
+		// --- ② そのログイン ID で登録済みでないか確認 ---
+		_, err = tx.Get(idRef)
+		if err == nil {
+			return ErrIdentityAlreadyLinked
+		}
+		if status.Code(err) != codes.NotFound {
+			metrics.FirestoreError(identitiesCollection, "read")
+			return err
+		}
+
+		// --- ③ 招待コードの役職・学年を反映してメンバーを作成 ---
+		m = inv.Apply(m, provider, subject, now)
+		m.Id = memberRef.ID
+		if err := checkMemberUnique(s.fs, tx, m); err != nil {
+			return err
+		}
+		if err := tx.Create(memberRef, m); err != nil {
+			return err
+		}
+		if err := tx.Create(idRef, Identity{
+			Provider:    provider,
+			Subject:     subject,
+			MemberID:    m.Id,
+			CreatedAt:   now,
+			LastLoginAt: now,
+		}); err != nil {
+			return err
+		}
+		return tx.Update(invRef, []firestore.Update{
+			{Path: "uses", Value: firestore.Increment(1)},
+			{Path: "redemptions", Value: firestore.ArrayUnion(Redemption{MemberID: m.Id, RedeemedAt: now})},
+		})
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvitationInvalid) && !errors.Is(err, ErrIdentityAlreadyLinked) && !errors.Is(err, ErrStudentIDTaken) && !errors.Is(err, ErrLineUserIDTaken) {
+			metrics.FirestoreError(invitationsCollection, "write")
+		}
+		return "", endSpanIfErr(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	metrics.FirestoreWrite(identitiesCollection, 1)
+	metrics.FirestoreWrite(invitationsCollection, 1)
+	span.SetAttributes(attribute.String("member.id", m.Id))
+	return m.Id, nil
+}
+
+// Apply は招待コードで本人が登録するメンバーを作る。
+// 役職は招待コードのものだけを使い、リクエストの役職は捨てる（自分で「代表」などを名乗って役員にならないように）。
+// 学年は招待コードに指定があればそれを使う。役員の承認が済むまで一覧に出さないよう承認待ちにする。
+// LINE で登録する場合は subject を LINE ユーザーID として持たせ、同じ LINE ユーザーの二重登録を防ぐ。
+func (inv *Invitation) Apply(m Member, provider, subject string, now time.Time) Member {
+	m.RoleIDs = slices.Clone(inv.RoleIDs)
+	m.Roles = slices.Clone(inv.Roles)
+	if inv.Year != "" {
+		m.Year = inv.Year
+	}
+	if provider == ProviderLINE {
+		m.Accounts.Line = true
+		m.LineUserID = subject
+	}
+	m.Status = StatusPending
+	m.CreatedAt = now
+	return m
+}
+
+// newInvitationCode はランダムな招待コードを生成する。
+func newInvitationCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(invitationCodeAlphabet)))
+	for range invitationCodeLength {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(invitationCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// ToAPI は Invitation を API レスポンス用の api.Invitation に変換する。
+func (inv *Invitation) ToAPI(now time.Time) api.Invitation {
+	out := api.Invitation{
+		Code:      inv.Code,
+		MaxUses:   inv.MaxUses,
+		Uses:      inv.Uses,
+		ExpiresAt: inv.ExpiresAt,
+		Roles:     inv.Roles,
+		CreatedBy: inv.CreatedBy,
+		CreatedAt: inv.CreatedAt,
+		Status:    api.InvitationStatus(inv.Status(now)),
+		Redemptions: make([]struct {
+			MemberId   string    `json:"member_id"`
+			RedeemedAt time.Time `json:"redeemed_at"`
+		}, 0, len(inv.Redemptions)),
+	}
+	if out.Roles == nil {
+		out.Roles = []string{}
+	}
+	if inv.Year != "" {
+		out.Year = &inv.Year
+	}
+	if inv.Note != "" {
+		out.Note = &inv.Note
+	}
+	for _, r := range inv.Redemptions {
+		out.Redemptions = append(out.Redemptions, struct {
+			MemberId   string    `json:"member_id"`
+			RedeemedAt time.Time `json:"redeemed_at"`
+		}{MemberId: r.MemberID, RedeemedAt: r.RedeemedAt})
+	}
+	return out
+}
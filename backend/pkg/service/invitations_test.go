@@ -0,0 +1,53 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitationStatus(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	inv := Invitation{MaxUses: 2, Uses: 1, ExpiresAt: now.Add(time.Hour)}
+	assert.Equal(t, InvitationOutstanding, inv.Status(now))
+
+	inv.Uses = 2
+	assert.Equal(t, InvitationRedeemed, inv.Status(now))
+
+	inv.Uses = 0
+	assert.Equal(t, InvitationExpired, inv.Status(now.Add(time.Hour)))
+}
+
+func TestInvitationApply(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	req := Member{Name: "山田 花子", RoleIDs: []string{"leader"}, Roles: []string{"代表"}, Status: StatusActive}
+
+	// 役職のない招待コードでも、リクエストの役職は使わない
+	m := (&Invitation{}).Apply(req, ProviderLINE, "U123", now)
+	assert.Empty(t, m.RoleIDs)
+	assert.Empty(t, m.Roles)
+	assert.Equal(t, StatusPending, m.Status)
+	assert.True(t, m.Accounts.Line)
+	assert.Equal(t, "U123", m.LineUserID)
+	assert.Equal(t, now, m.CreatedAt)
+
+	m = (&Invitation{RoleIDs: []string{"web"}, Roles: []string{"Web班"}, Year: "1年生"}).Apply(req, "google", "g-1", now)
+	assert.Equal(t, []string{"web"}, m.RoleIDs)
+	assert.Equal(t, []string{"Web班"}, m.Roles)
+	assert.Equal(t, "1年生", m.Year)
+	assert.False(t, m.Accounts.Line)
+	assert.Empty(t, m.LineUserID)
+}
+
+func TestNormalizeInvitationCode(t *testing.T) {
+	assert.Equal(t, "K7MZQ4PXWA", NormalizeInvitationCode(" k7mz-q4px　wa "))
+}
+
+func TestNewInvitationCode(t *testing.T) {
+	code, err := newInvitationCode()
+	assert.NoError(t, err)
+	assert.Len(t, code, invitationCodeLength)
+	assert.Equal(t, code, NormalizeInvitationCode(code))
+}
@@ -2,10 +2,40 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/logging"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const membersCollection = "members"
+
+var (
+	// ErrMemberNotFound は指定IDのメンバーが存在しないことを表す。
+	ErrMemberNotFound = errors.New("member not found")
+	// ErrAccountAlreadyLinked は外部アカウントが別のメンバーに連携済みであることを表す。
+	ErrAccountAlreadyLinked = errors.New("account is already linked to another member")
+	// ErrStatusConflict は現在の在籍状況ではその操作ができないことを表す（承認待ちでないメンバーの承認など）。
+	ErrStatusConflict = errors.New("member status does not allow this operation")
+	// ErrStudentIDTaken は学籍番号が別のメンバーに登録済みであることを表す。
+	ErrStudentIDTaken = errors.New("student id is already registered")
+	// ErrLineUserIDTaken は LINE ユーザーIDが別のメンバーに紐づいていることを表す。
+	ErrLineUserIDTaken = errors.New("line user id is already registered")
+)
+
+var tracer = otel.Tracer("github.com/Lumos-Programming/profile-system-backend/pkg/service")
+
 // MembersService は Firestore の "members" コレクションに対する操作を提供する。
 type MembersService struct {
 	fs *firestore.Client
@@ -16,14 +46,631 @@ func NewMembersService(fs *firestore.Client) *MembersService {
 	return &MembersService{fs: fs}
 }
 
+// List は "members" コレクションの全ドキュメントを読み込む。
+// 型が壊れている（Member にデコードできない）ドキュメントは Warn ログを残して除外し、残りを返す。
+// 統合済み・削除済みのメンバーは含めない。
+func (s *MembersService) List(ctx context.Context) ([]Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.List")
+	defer span.End()
+
+	iter := s.fs.Collection(membersCollection).Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]Member, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		// Firestore から取れない（通信/権限/一時障害など）場合は一覧全体を失敗扱いにする
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "read")
+			return nil, endSpan(span, err)
+		}
+		metrics.FirestoreRead(membersCollection, 1)
+
+		// ドキュメントを struct(Member) にデコード（型変換）
+		// Firestoreのデータ型が struct と一致していれば成功する
+		// 例）roles が []string で入っている、name が string で入っている、など
+		var m Member
+		if err := doc.DataTo(&m); err != nil {
+			// デコード失敗時の扱い
+			// AFTER（現在の方針）：
+			//   ・データ型が壊れている/想定外なら "壊れたドキュメント" とみなす
+			//   ・Warnログを残して、その1件は一覧から除外（skip）する
+			//   ・一覧API自体は落とさない（残りの正常データは返す）
+			//
+			// BEFORE（元の方針）は下のコメントアウト参照：
+			//   ・doc.Data() の map を直接読んで
+			//   ・asString / asStringSlice で無理やり値を拾って一覧に混ぜて返していた
+			//   ・ただし不整合が「空文字/空配列」になって気づきにくい副作用があった
+
+			// BEFORE (元コードの fallback：参考として残す)
+			// slog.Warn("failed to parse document into MemberDetail, will fallback to raw map", "doc", doc.Ref.ID, "error", err)
+			//
+			// // fallback: minimal summary from raw map (map[string]any を直接読む)
+			// m := doc.Data()
+			// // asString/asStringSlice で型を"それっぽく"合わせて MemberSummary を作る
+			// s := api.MemberSummary{
+			// 	Id:       doc.Ref.ID,
+			// 	Name:     asString(m["name"]),
+			// 	Nickname: asString(m["nickname"]),
+			// 	Roles:    asStringSlice(m["roles"]),
+			// }
+			// if avatar := asString(m["avatar"]); avatar != "" {
+			// 	s.Avatar = &avatar
+			// }
+			// out = append(out, s)
+
+			// AFTER（現在：壊れたdocは skip）
+			// request_id 付きのロガーで出すので、どのリクエストで気づいたかを追える
+			logging.FromContext(ctx).Warn("failed to parse document into Member, skip", "doc", doc.Ref.ID, "error", err)
+			metrics.MalformedMemberSkipped()
+			continue
+		}
+		// id が空なら docID で補う
+		if m.Id == "" {
+			m.Id = doc.Ref.ID
+		}
+		// 統合済みのメンバーは統合先に含まれているので数えない。削除済みのメンバーは ListDeleted で取る
+		if m.MergedInto != "" || m.Deleted() {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	span.SetAttributes(attribute.Int("members.count", len(out)))
+	return out, nil
+}
+
+// Get は指定IDのメンバーを取得する。存在しない場合は ErrMemberNotFound を返す。
+func (s *MembersService) Get(ctx context.Context, id string) (*Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.Get", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	docSnap, err := s.fs.Collection(membersCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrMemberNotFound
+		}
+		metrics.FirestoreError(membersCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(membersCollection, 1)
+
+	// 取得したドキュメントを struct(Member) にデコード（型変換）
+	// Firestore上のデータ型が struct と一致しないと失敗する
+	// 例：roles が []string ではなく string だった、name が number だった、など
+	var m Member
+	if err := docSnap.DataTo(&m); err != nil {
+		// デコード失敗：詳細取得は「その1件が返せない」= 失敗なので、ログをErrorで残してエラーを返す（API では 500）
+		logging.FromContext(ctx).Error("failed to parse member document", "doc", docSnap.Ref.ID, "error", err)
+		return nil, endSpan(span, err)
+	}
+	// Firestoreのフィールドに id が入っていない/空でも、docのIDを返すようにする
+	if m.Id == "" {
+		m.Id = docSnap.Ref.ID
+	}
+	return &m, nil
+}
+
+// RecordLineLogin は LINE ログインで検証できた情報をメンバーに保存する。
+// メールアドレスはメール取得権限がない場合は空なので、そのときは上書きしない。
+func (s *MembersService) RecordLineLogin(ctx context.Context, id, email string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.RecordLineLogin", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	updates := []firestore.Update{
+		{Path: "accounts.line", Value: true},
+	}
+	if email != "" {
+		updates = append(updates, firestore.Update{Path: "email", Value: email})
+	}
+	if _, err := s.fs.Collection(membersCollection).Doc(id).Update(ctx, updates); err != nil {
+		metrics.FirestoreError(membersCollection, "write")
+		return endSpan(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	return nil
+}
+
+// LinkAccount は provider（discord など）のアカウントをメンバーに紐づけ、accounts.<provider> を true にする。
+// 同じアカウントが別のメンバーに連携済みなら ErrAccountAlreadyLinked を返す。
+func (s *MembersService) LinkAccount(ctx context.Context, id, provider string, acc LinkedAccount) error {
+	ctx, span := tracer.Start(ctx, "MembersService.LinkAccount", trace.WithAttributes(
+		attribute.String("member.id", id),
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	col := s.fs.Collection(membersCollection)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// --- ① 同じ外部アカウントが他のメンバーに紐づいていないか確認 ---
+		docs, err := tx.Documents(col.Where(provider+".id", "==", acc.ID).Limit(1)).GetAll()
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(membersCollection, len(docs))
+		if len(docs) > 0 && docs[0].Ref.ID != id {
+			return ErrAccountAlreadyLinked
+		}
+
+		// --- ② 紐づけと accounts フラグを同時に更新 ---
+		err = tx.Update(col.Doc(id), []firestore.Update{
+			{Path: provider, Value: acc},
+			{Path: "accounts." + provider, Value: true},
+		})
+		if status.Code(err) == codes.NotFound {
+			return ErrMemberNotFound
+		}
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		return nil
+	}))
+}
+
+// UnlinkAccount は provider のアカウント連携を外し、accounts.<provider> を false にする。
+func (s *MembersService) UnlinkAccount(ctx context.Context, id, provider string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.UnlinkAccount", trace.WithAttributes(
+		attribute.String("member.id", id),
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	_, err := s.fs.Collection(membersCollection).Doc(id).Update(ctx, []firestore.Update{
+		{Path: provider, Value: firestore.Delete},
+		{Path: "accounts." + provider, Value: false},
+	})
+	if status.Code(err) == codes.NotFound {
+		return ErrMemberNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "write")
+		return endSpan(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	return nil
+}
+
 // Register は Member を Firestore の "members" コレクションに登録する。
+// 学籍番号・LINE ユーザーID が登録済みのメンバーと同じなら ErrStudentIDTaken / ErrLineUserIDTaken。
 // 戻り値はドキュメントIDとエラー。
 func (s *MembersService) Register(ctx context.Context, m Member) (string, error) {
-	doc := s.fs.Collection("members").NewDoc()
+	ctx, span := tracer.Start(ctx, "MembersService.Register")
+	defer span.End()
+
+	doc := s.fs.Collection(membersCollection).NewDoc()
 	m.Id = doc.ID // Firestore のドキュメント ID を id フィールドにも保持
-	_, err := doc.Set(ctx, m)
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now()
+	}
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := checkMemberUnique(s.fs, tx, m); err != nil {
+			return err
+		}
+		return tx.Create(doc, m)
+	})
 	if err != nil {
-		return "", err
+		if !errors.Is(err, ErrStudentIDTaken) && !errors.Is(err, ErrLineUserIDTaken) {
+			metrics.FirestoreError(membersCollection, "write")
+		}
+		return "", endSpan(span, err)
 	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	span.SetAttributes(attribute.String("member.id", doc.ID))
 	return doc.ID, nil
 }
+
+// checkMemberUnique は m の学籍番号・LINE ユーザーID が m 以外のメンバーに使われていれば
+// ErrStudentIDTaken / ErrLineUserIDTaken を返す（統合済みのメンバーは数えない）。トランザクションの中で使う。
+func checkMemberUnique(fs *firestore.Client, tx *firestore.Transaction, m Member) error {
+	col := fs.Collection(membersCollection)
+	for _, key := range []struct {
+		path, value string
+		err         error
+	}{
+		{"student_id", m.StudentID, ErrStudentIDTaken},
+		{"line_user_id", m.LineUserID, ErrLineUserIDTaken},
+	} {
+		if key.value == "" {
+			continue
+		}
+		docs, err := tx.Documents(col.Where(key.path, "==", key.value)).GetAll()
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(membersCollection, len(docs))
+		for _, d := range docs {
+			merged, _ := d.DataAt("merged_into")
+			if d.Ref.ID != m.Id && merged == nil {
+				return fmt.Errorf("%w: %s", key.err, key.value)
+			}
+		}
+	}
+	return nil
+}
+
+// endSpanIfErr は err が nil でなければスパンに記録する。
+func endSpanIfErr(span trace.Span, err error) error {
+	if err != nil {
+		return endSpan(span, err)
+	}
+	return nil
+}
+
+// endSpan はスパンにエラーを記録して err をそのまま返す。
+func endSpan(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(otelcodes.Error, err.Error())
+	return err
+}
+
+// UpdateStatus は在籍状況を to に変更する。現在の在籍状況が from のいずれでもなければ ErrStatusConflict。
+func (s *MembersService) UpdateStatus(ctx context.Context, id string, from []string, to string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.UpdateStatus", trace.WithAttributes(
+		attribute.String("member.id", id),
+		attribute.String("member.status", to),
+	))
+	defer span.End()
+
+	ref := s.fs.Collection(membersCollection).Doc(id)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		m, err := getMemberTx(tx, ref)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(from, m.EffectiveStatus()) {
+			return fmt.Errorf("%w: %s", ErrStatusConflict, m.EffectiveStatus())
+		}
+		if err := tx.Update(ref, []firestore.Update{{Path: "status", Value: to}}); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		return nil
+	}))
+}
+
+// DeletePending は承認待ちのメンバーを、member_identities の紐づけごと削除する（却下）。
+// 承認待ちでなければ ErrStatusConflict。
+func (s *MembersService) DeletePending(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.DeletePending", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(membersCollection).Doc(id)
+	identities := s.fs.Collection(identitiesCollection).Where("member_id", "==", id)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		m, err := getMemberTx(tx, ref)
+		if err != nil {
+			return err
+		}
+		if m.EffectiveStatus() != StatusPending {
+			return fmt.Errorf("%w: %s", ErrStatusConflict, m.EffectiveStatus())
+		}
+		docs, err := tx.Documents(identities).GetAll()
+		if err != nil {
+			metrics.FirestoreError(identitiesCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(identitiesCollection, len(docs))
+
+		for _, d := range docs {
+			if err := tx.Delete(d.Ref); err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(ref); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(membersCollection, 1)
+		metrics.FirestoreWrite(identitiesCollection, len(docs))
+		return nil
+	}))
+}
+
+// getMemberTx はトランザクション内でメンバーを 1 件読む。
+func getMemberTx(tx *firestore.Transaction, ref *firestore.DocumentRef) (*Member, error) {
+	doc, err := tx.Get(ref)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "read")
+		return nil, err
+	}
+	metrics.FirestoreRead(membersCollection, 1)
+
+	var m Member
+	if err := doc.DataTo(&m); err != nil {
+		return nil, err
+	}
+	if m.Id == "" {
+		m.Id = doc.Ref.ID
+	}
+	return &m, nil
+}
+
+// PromoteGraduates は修業年限を終えた在籍中のメンバーを卒業生（alumni）にまとめて移す。
+// dryRun の場合は書き込まずに対象だけを返す。書き込みは BulkWriter でまとめて行う。
+func (s *MembersService) PromoteGraduates(ctx context.Context, now time.Time, dryRun bool) ([]Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.PromoteGraduates", trace.WithAttributes(
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	members, err := s.List(ctx)
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	graduates := make([]Member, 0)
+	for _, m := range members {
+		if m.EffectiveStatus() == StatusActive && m.GraduatedAt(now) {
+			graduates = append(graduates, m)
+		}
+	}
+	span.SetAttributes(attribute.Int("members.count", len(graduates)))
+	if dryRun || len(graduates) == 0 {
+		return graduates, nil
+	}
+
+	bw := s.fs.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(graduates))
+	for _, m := range graduates {
+		ref := s.fs.Collection(membersCollection).Doc(m.Id)
+		job, err := bw.Update(ref, []firestore.Update{{Path: "status", Value: StatusAlumni}})
+		if err != nil {
+			bw.End()
+			return nil, endSpan(span, err)
+		}
+		jobs = append(jobs, job)
+	}
+	bw.End()
+
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return graduates[:i], endSpan(span, fmt.Errorf("promote %s: %w", graduates[i].Id, err))
+		}
+	}
+	metrics.FirestoreWrite(membersCollection, len(jobs))
+	return graduates, nil
+}
+
+// BackfillRoleIDs は role_ids のない（teams コレクションができる前に登録された）メンバーについて、
+// roles（名前）を teams から探して role_ids を設定する。名前は ResolveTeams と同じく表記ゆれを吸収する。
+// 見つからない名前があるメンバーは書き換えずに unresolved で返す（班・役職を作ってから再実行する）。
+// dryRun の場合は書き込まずに対象だけを返す。書き込みは BulkWriter でまとめて行う。
+func (s *MembersService) BackfillRoleIDs(ctx context.Context, teams []Team, dryRun bool) (updated, unresolved []Member, err error) {
+	ctx, span := tracer.Start(ctx, "MembersService.BackfillRoleIDs", trace.WithAttributes(
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	members, err := s.List(ctx)
+	if err != nil {
+		return nil, nil, endSpan(span, err)
+	}
+	for _, m := range members {
+		if len(m.RoleIDs) > 0 || len(m.Roles) == 0 {
+			continue
+		}
+		resolved, err := ResolveTeams(teams, m.Roles)
+		if err != nil {
+			unresolved = append(unresolved, m)
+			continue
+		}
+		m.SetTeams(resolved)
+		updated = append(updated, m)
+	}
+	span.SetAttributes(attribute.Int("members.count", len(updated)), attribute.Int("members.unresolved", len(unresolved)))
+	if dryRun || len(updated) == 0 {
+		return updated, unresolved, nil
+	}
+
+	bw := s.fs.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(updated))
+	for _, m := range updated {
+		ref := s.fs.Collection(membersCollection).Doc(m.Id)
+		job, err := bw.Update(ref, []firestore.Update{{Path: "role_ids", Value: m.RoleIDs}, {Path: "roles", Value: m.Roles}})
+		if err != nil {
+			bw.End()
+			return nil, unresolved, endSpan(span, err)
+		}
+		jobs = append(jobs, job)
+	}
+	bw.End()
+
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			metrics.FirestoreError(membersCollection, "write")
+			return updated[:i], unresolved, endSpan(span, fmt.Errorf("backfill %s: %w", updated[i].Id, err))
+		}
+	}
+	metrics.FirestoreWrite(membersCollection, len(jobs))
+	return updated, unresolved, nil
+}
+
+// ListByRole は指定した班・役職に所属するメンバーを返す。
+func (s *MembersService) ListByRole(ctx context.Context, teamID string) ([]Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.ListByRole", trace.WithAttributes(attribute.String("team.id", teamID)))
+	defer span.End()
+
+	docs, err := s.fs.Collection(membersCollection).Where("role_ids", "array-contains", teamID).Documents(ctx).GetAll()
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(membersCollection, len(docs))
+
+	out := make([]Member, 0, len(docs))
+	for _, doc := range docs {
+		var m Member
+		if err := doc.DataTo(&m); err != nil {
+			logging.FromContext(ctx).Warn("failed to parse document into Member, skip", "doc", doc.Ref.ID, "error", err)
+			metrics.MalformedMemberSkipped()
+			continue
+		}
+		if m.Id == "" {
+			m.Id = doc.Ref.ID
+		}
+		if m.Deleted() {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// UpdateTags はメンバーのスキル・興味タグを置き換える。tags は NormalizeTags 済みであること。
+func (s *MembersService) UpdateTags(ctx context.Context, id string, tags []string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.UpdateTags", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	_, err := s.fs.Collection(membersCollection).Doc(id).Update(ctx, []firestore.Update{{Path: "tags", Value: tags}})
+	if status.Code(err) == codes.NotFound {
+		return ErrMemberNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "write")
+		return endSpan(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	return nil
+}
+
+// UpdateSocial は SNS のユーザー名と公開設定を置き換える。handles は NormalizeSocialHandles 済みであること。
+func (s *MembersService) UpdateSocial(ctx context.Context, id string, handles SocialHandles, vis Visibility) error {
+	ctx, span := tracer.Start(ctx, "MembersService.UpdateSocial", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	_, err := s.fs.Collection(membersCollection).Doc(id).Update(ctx, []firestore.Update{
+		{Path: "social", Value: handles},
+		{Path: "visibility", Value: vis},
+	})
+	if status.Code(err) == codes.NotFound {
+		return ErrMemberNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "write")
+		return endSpan(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 1)
+	return nil
+}
+
+// importBatchSize は Import で 1 回の BulkWriter にまとめて書き込む件数。
+const importBatchSize = 500
+
+// Import は members をまとめて登録し、登録できたメンバーの ID と行ごとのエラーを members と同じ順で返す
+// （失敗した分の ID は空文字、成功した分のエラーは nil）。
+// 学籍番号の重複は書き込む前に全行分をまとめて確かめ、登録済みのメンバーや前の行と重なる行は ErrStudentIDTaken にする。
+// 書き込みは importBatchSize 件ずつ BulkWriter で行い、書き込めなかった行はそのエラーを返す。
+// 行ごとのトランザクションは使わないので、確認から書き込みまでの間に同じ学籍番号が登録されると重複しうる。
+func (s *MembersService) Import(ctx context.Context, members []Member) ([]string, []error) {
+	ctx, span := tracer.Start(ctx, "MembersService.Import")
+	defer span.End()
+	span.SetAttributes(attribute.Int("members.count", len(members)))
+
+	ids := make([]string, len(members))
+	errs := make([]error, len(members))
+	fail := func(err error) ([]string, []error) {
+		for i := range errs {
+			errs[i] = err
+		}
+		return make([]string, len(members)), errs
+	}
+
+	// --- ① 学籍番号の重複をまとめて確認 ---
+	studentIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.StudentID != "" {
+			studentIDs = append(studentIDs, m.StudentID)
+		}
+	}
+	taken, err := s.takenStudentIDs(ctx, studentIDs)
+	if err != nil {
+		return fail(endSpan(span, err))
+	}
+
+	// --- ② 重複のない行を importBatchSize 件ずつ書き込む ---
+	now := time.Now()
+	col := s.fs.Collection(membersCollection)
+	rows := make([]int, 0, len(members))
+	for i, m := range members {
+		if m.StudentID != "" {
+			if taken[m.StudentID] {
+				errs[i] = fmt.Errorf("%w: %s", ErrStudentIDTaken, m.StudentID)
+				continue
+			}
+			taken[m.StudentID] = true
+		}
+		rows = append(rows, i)
+	}
+	for chunk := range slices.Chunk(rows, importBatchSize) {
+		bw := s.fs.BulkWriter(ctx)
+		jobs := make([]*firestore.BulkWriterJob, len(chunk))
+		for j, i := range chunk {
+			m := members[i]
+			ref := col.NewDoc()
+			m.Id = ref.ID
+			if m.CreatedAt.IsZero() {
+				m.CreatedAt = now
+			}
+			ids[i] = ref.ID
+			jobs[j], errs[i] = bw.Create(ref, m)
+		}
+		bw.End()
+
+		written := 0
+		for j, i := range chunk {
+			if errs[i] == nil {
+				_, errs[i] = jobs[j].Results()
+			}
+			if errs[i] != nil {
+				metrics.FirestoreError(membersCollection, "write")
+				ids[i] = ""
+				continue
+			}
+			written++
+		}
+		metrics.FirestoreWrite(membersCollection, written)
+	}
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	span.SetAttributes(attribute.Int("members.failed", failed))
+	return ids, errs
+}
+
+// takenStudentIDs は studentIDs のうち登録済みのメンバー（統合済みを除く）が使っているものを返す。
+// Firestore の in 句は 30 件までなので、30 件ずつに分けて問い合わせる。
+func (s *MembersService) takenStudentIDs(ctx context.Context, studentIDs []string) (map[string]bool, error) {
+	taken := make(map[string]bool)
+	for chunk := range slices.Chunk(studentIDs, 30) {
+		docs, err := s.fs.Collection(membersCollection).Where("student_id", "in", chunk).Documents(ctx).GetAll()
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "read")
+			return nil, err
+		}
+		metrics.FirestoreRead(membersCollection, len(docs))
+		for _, d := range docs {
+			if merged, _ := d.DataAt("merged_into"); merged != nil {
+				continue
+			}
+			if id, ok := d.Data()["student_id"].(string); ok {
+				taken[id] = true
+			}
+		}
+	}
+	return taken, nil
+}
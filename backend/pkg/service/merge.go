@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrAlreadyMerged は統合しようとしたメンバーのどちらかが既に別のメンバーに統合されていることを表す。
+var ErrAlreadyMerged = errors.New("member is already merged into another member")
+
+// MergeMembers は source を target にまとめたメンバーを返す（ID は target のもの）。
+//   - 名前などの項目は target の値を優先し、target が空のものだけ source から補う
+//   - 役職・タグ・リンク・イベントは両方を合わせる（重複は 1 つにする。タグは MaxTags まで）
+//   - 在籍状況は target のもの。ただし target が承認待ちなら source のもの
+//   - 登録日時は早いほう
+func MergeMembers(target, source Member) Member {
+	m := target
+	fill := func(dst *string, src string) {
+		if strings.TrimSpace(*dst) == "" {
+			*dst = src
+		}
+	}
+	fill(&m.Name, source.Name)
+	fill(&m.Nickname, source.Nickname)
+	fill(&m.Department, source.Department)
+	fill(&m.Bio, source.Bio)
+	fill(&m.Year, source.Year)
+	fill(&m.StudentID, source.StudentID)
+	fill(&m.LineUserID, source.LineUserID)
+	fill(&m.Email, source.Email)
+	fill(&m.Social.X, source.Social.X)
+	fill(&m.Social.Instagram, source.Social.Instagram)
+	fill(&m.Social.GitHub, source.Social.GitHub)
+	fill(&m.Social.Website, source.Social.Website)
+	if m.Avatar == nil {
+		m.Avatar = source.Avatar
+	}
+	if m.EnrollmentYear == 0 {
+		m.EnrollmentYear, m.ProgramYears = source.EnrollmentYear, source.ProgramYears
+	}
+	if m.Discord == nil {
+		m.Discord = source.Discord
+	}
+	if m.GitHub == nil {
+		m.GitHub = source.GitHub
+	}
+	m.Accounts.Discord = m.Discord != nil
+	m.Accounts.Github = m.GitHub != nil
+	m.Accounts.Line = target.Accounts.Line || source.Accounts.Line
+	if m.EffectiveStatus() == StatusPending {
+		m.Status = source.Status
+	}
+	if !source.CreatedAt.IsZero() && (m.CreatedAt.IsZero() || source.CreatedAt.Before(m.CreatedAt)) {
+		m.CreatedAt = source.CreatedAt
+	}
+
+	// 役職は RoleIDs と Roles の並びをそろえたまま足す
+	m.RoleIDs, m.Roles = slices.Clone(target.RoleIDs), slices.Clone(target.Roles)
+	for i, id := range source.RoleIDs {
+		if !slices.Contains(m.RoleIDs, id) && i < len(source.Roles) {
+			m.RoleIDs = append(m.RoleIDs, id)
+			m.Roles = append(m.Roles, source.Roles[i])
+		}
+	}
+	// RoleIDs のない（班の管理を入れる前の）役職名は名前で合わせる
+	if len(source.RoleIDs) == 0 {
+		for _, r := range source.Roles {
+			if !slices.Contains(m.Roles, r) {
+				m.Roles = append(m.Roles, r)
+			}
+		}
+	}
+
+	m.Tags = slices.Clone(target.Tags)
+	for _, t := range source.Tags {
+		if !slices.Contains(m.Tags, t) && len(m.Tags) < MaxTags {
+			m.Tags = append(m.Tags, t)
+		}
+	}
+
+	m.Links = slices.Clone(target.Links)
+	for _, l := range source.Links {
+		if !m.hasLink(strings.TrimRight(l.Url, "/")) {
+			m.Links = append(m.Links, l)
+		}
+	}
+
+	m.Events = slices.Clone(target.Events)
+	for _, e := range source.Events {
+		if !slices.ContainsFunc(m.Events, func(x Event) bool { return x.Name == e.Name && x.Date.Equal(e.Date) }) {
+			m.Events = append(m.Events, e)
+		}
+	}
+	slices.SortStableFunc(m.Events, func(a, b Event) int { return a.Date.Compare(b.Date) })
+	return m
+}
+
+// Merge は sourceID のメンバーを targetID のメンバーに統合し、統合後のメンバーを返す。
+// source のログイン ID（member_identities）・役職の任期（role_terms）・招待コードの利用記録も target に付け替える。
+// source のドキュメントは統合先（merged_into）だけを残して中身を消す。すべて 1 つのトランザクションで行う。
+// どちらかが見つからなければ ErrMemberNotFound、統合済みなら ErrAlreadyMerged、削除済みなら ErrMemberDeleted。
+func (s *MembersService) Merge(ctx context.Context, targetID, sourceID string) (*Member, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.Merge", trace.WithAttributes(
+		attribute.String("member.id", targetID),
+		attribute.String("source.id", sourceID),
+	))
+	defer span.End()
+
+	col := s.fs.Collection(membersCollection)
+	var merged Member
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// --- ① 両方のメンバーを読む ---
+		var pair [2]Member
+		for i, ref := range []*firestore.DocumentRef{col.Doc(targetID), col.Doc(sourceID)} {
+			doc, err := tx.Get(ref)
+			if status.Code(err) == codes.NotFound {
+				return ErrMemberNotFound
+			}
+			if err != nil {
+				metrics.FirestoreError(membersCollection, "read")
+				return err
+			}
+			metrics.FirestoreRead(membersCollection, 1)
+			if err := doc.DataTo(&pair[i]); err != nil {
+				return err
+			}
+			pair[i].Id = ref.ID
+			switch {
+			case pair[i].MergedInto != "":
+				return ErrAlreadyMerged
+			case pair[i].Deleted():
+				return ErrMemberDeleted
+			}
+		}
+
+		// --- ② 付け替えるログイン ID と任期を探す ---
+		identities, err := tx.Documents(s.fs.Collection(identitiesCollection).Where("member_id", "==", sourceID)).GetAll()
+		if err != nil {
+			metrics.FirestoreError(identitiesCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(identitiesCollection, len(identities))
+		terms, err := tx.Documents(s.fs.Collection(roleTermsCollection).Where("member_id", "==", sourceID)).GetAll()
+		if err != nil {
+			metrics.FirestoreError(roleTermsCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(roleTermsCollection, len(terms))
+		// 利用記録は配列の中にあって絞り込めないので、全件から探す
+		invitations, err := tx.Documents(s.fs.Collection(invitationsCollection)).GetAll()
+		if err != nil {
+			metrics.FirestoreError(invitationsCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(invitationsCollection, len(invitations))
+
+		// --- ③ 書き込み ---
+		merged = MergeMembers(pair[0], pair[1])
+		if err := tx.Set(col.Doc(targetID), merged); err != nil {
+			return err
+		}
+		if err := tx.Set(col.Doc(sourceID), Member{Id: sourceID, MergedInto: targetID, MergedAt: time.Now()}); err != nil {
+			return err
+		}
+		for _, doc := range identities {
+			if err := tx.Update(doc.Ref, []firestore.Update{{Path: "member_id", Value: targetID}}); err != nil {
+				return err
+			}
+		}
+		for _, doc := range terms {
+			if err := tx.Update(doc.Ref, []firestore.Update{{Path: "member_id", Value: targetID}}); err != nil {
+				return err
+			}
+		}
+		for _, doc := range invitations {
+			var inv Invitation
+			if err := doc.DataTo(&inv); err != nil {
+				return err
+			}
+			redemptions, changed := repointRedemptions(inv.Redemptions, sourceID, targetID)
+			if !changed {
+				continue
+			}
+			if err := tx.Update(doc.Ref, []firestore.Update{{Path: "redemptions", Value: redemptions}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrMemberNotFound) && !errors.Is(err, ErrAlreadyMerged) && !errors.Is(err, ErrMemberDeleted) {
+			metrics.FirestoreError(membersCollection, "write")
+		}
+		return nil, endSpanIfErr(span, err)
+	}
+	metrics.FirestoreWrite(membersCollection, 2)
+	return &merged, nil
+}
+
+// repointRedemptions は from のメンバーの利用記録を to のものに付け替えた redemptions と、付け替えたかどうかを返す。
+func repointRedemptions(redemptions []Redemption, from, to string) ([]Redemption, bool) {
+	out := slices.Clone(redemptions)
+	changed := false
+	for i := range out {
+		if out[i].MemberID == from {
+			out[i].MemberID = to
+			changed = true
+		}
+	}
+	return out, changed
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMergeMembers(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 4, d, 0, 0, 0, 0, time.UTC) }
+	target := Member{
+		Id:        "m-new",
+		Name:      "田中 太郎",
+		Status:    StatusPending,
+		RoleIDs:   []string{"web"},
+		Roles:     []string{"Web班"},
+		Tags:      []string{"go"},
+		Events:    []Event{{Name: "新歓", Date: day(10)}},
+		CreatedAt: day(20),
+	}
+	target.Links = append(target.Links, struct {
+		Title string `firestore:"title"`
+		Url   string `firestore:"url"`
+	}{Title: "blog", Url: "https://example.com/"})
+	source := Member{
+		Id:         "m-old",
+		Name:       "たなか",
+		Nickname:   "たろう",
+		Department: "情報工学科",
+		StudentID:  "24T0001",
+		LineUserID: "U1",
+		Status:     StatusActive,
+		RoleIDs:    []string{"web", "game"},
+		Roles:      []string{"Web班", "ゲーム班"},
+		Tags:       []string{"go", "unity"},
+		Events:     []Event{{Name: "新歓", Date: day(10)}, {Name: "ハッカソン", Date: day(1)}},
+		CreatedAt:  day(1),
+	}
+	source.Links = append(source.Links, struct {
+		Title string `firestore:"title"`
+		Url   string `firestore:"url"`
+	}{Title: "same", Url: "https://example.com"})
+
+	m := MergeMembers(target, source)
+
+	if m.Id != "m-new" || m.Name != "田中 太郎" {
+		t.Fatalf("target fields should win: %+v", m)
+	}
+	if m.Nickname != "たろう" || m.Department != "情報工学科" || m.StudentID != "24T0001" || m.LineUserID != "U1" {
+		t.Fatalf("empty fields should be filled from source: %+v", m)
+	}
+	if m.Status != StatusActive {
+		t.Fatalf("pending target should take source status, got %q", m.Status)
+	}
+	if !m.CreatedAt.Equal(day(1)) {
+		t.Fatalf("expected earlier created_at, got %v", m.CreatedAt)
+	}
+	if !slices.Equal(m.RoleIDs, []string{"web", "game"}) || !slices.Equal(m.Roles, []string{"Web班", "ゲーム班"}) {
+		t.Fatalf("unexpected roles: %v %v", m.RoleIDs, m.Roles)
+	}
+	if !slices.Equal(m.Tags, []string{"go", "unity"}) {
+		t.Fatalf("unexpected tags: %v", m.Tags)
+	}
+	if len(m.Links) != 1 {
+		t.Fatalf("same link should not be duplicated: %+v", m.Links)
+	}
+	if len(m.Events) != 2 || m.Events[0].Name != "ハッカソン" {
+		t.Fatalf("events should be merged and sorted by date: %+v", m.Events)
+	}
+	// 元のメンバーの slice は書き換えない
+	if len(target.Roles) != 1 || len(target.Tags) != 1 {
+		t.Fatalf("target was modified: %+v", target)
+	}
+}
+
+func TestRepointRedemptions(t *testing.T) {
+	at := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	rs := []Redemption{{MemberID: "a", RedeemedAt: at}, {MemberID: "b", RedeemedAt: at}}
+
+	got, changed := repointRedemptions(rs, "b", "a")
+	if !changed || !slices.Equal(got, []Redemption{{MemberID: "a", RedeemedAt: at}, {MemberID: "a", RedeemedAt: at}}) {
+		t.Fatalf("unexpected redemptions: %v %v", got, changed)
+	}
+	// 元の配列は書き換えない
+	if rs[1].MemberID != "b" {
+		t.Fatalf("input was modified: %v", rs)
+	}
+	if _, changed := repointRedemptions(rs, "c", "a"); changed {
+		t.Fatal("nothing to repoint, but changed is true")
+	}
+}
@@ -1,6 +1,12 @@
 package service
 
-import api "github.com/Lumos-Programming/profile-system-backend/api"
+import (
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+)
 
 // Member は Firestore に保存するメンバー情報の構造体。
 // firestore タグで Firestore フィールド名を明示する。
@@ -10,57 +16,246 @@ type Member struct {
 		Github  bool `firestore:"github"`
 		Line    bool `firestore:"line"`
 	} `firestore:"accounts"`
-	Avatar     *string `firestore:"avatar,omitempty"`
-	Bio        string  `firestore:"bio"`
-	Department string  `firestore:"department"`
-	Id         string  `firestore:"id"`
+	Avatar *string `firestore:"avatar,omitempty"`
+	// Discord は OAuth で連携した Discord アカウント。Accounts.Discord はこの有無と一致させる
+	Discord *LinkedAccount `firestore:"discord,omitempty"`
+	Bio     string         `firestore:"bio"`
+	// CreatedAt は登録日時（承認待ちの並び順に使う）
+	CreatedAt time.Time `firestore:"created_at,omitempty"`
+	// DeletedAt は役員が削除した日時。削除済みのメンバーは一覧に出さず、保持期間を過ぎたら完全に削除する
+	DeletedAt  time.Time `firestore:"deleted_at,omitempty"`
+	Department string    `firestore:"department"`
+	// EnrollmentYear は入学年度（例 2024）。設定されていれば表示用の学年はここから計算する
+	EnrollmentYear int `firestore:"enrollment_year,omitempty"`
+	// Email は LINE の ID トークンで検証済みのメールアドレス（API レスポンスには含めない）
+	Email  string  `firestore:"email,omitempty"`
+	Events []Event `firestore:"events,omitempty"`
+	// GitHub は OAuth で連携した GitHub アカウント（Username は login）。Accounts.Github はこの有無と一致させる
+	GitHub *LinkedAccount `firestore:"github,omitempty"`
+	Id     string         `firestore:"id"`
+	// LineUserID はこのメンバーに紐づく LINE ユーザーID（ID トークンの sub）
+	LineUserID string `firestore:"line_user_id,omitempty"`
 	Links      []struct {
 		Title string `firestore:"title"`
 		Url   string `firestore:"url"`
 	} `firestore:"links"`
-	Name     string   `firestore:"name"`
-	Nickname string   `firestore:"nickname"`
-	Roles    []string `firestore:"roles"`
-	Year     string   `firestore:"year"`
+	// MergedInto は別のメンバーに統合されたときの統合先 ID。統合済みのメンバーは一覧に出さず、詳細は統合先へリダイレクトする
+	MergedInto string    `firestore:"merged_into,omitempty"`
+	MergedAt   time.Time `firestore:"merged_at,omitempty"`
+	Name       string    `firestore:"name"`
+	Nickname   string    `firestore:"nickname"`
+	// ProgramYears は修業年限（学部 4、修士 2 など）。0 なら DefaultProgramYears
+	ProgramYears int `firestore:"program_years,omitempty"`
+	// RoleIDs は所属する班・役職（"teams" コレクション）の ID。Roles はその表示用の名前で、順番も揃える
+	RoleIDs []string `firestore:"role_ids,omitempty"`
+	Roles   []string `firestore:"roles"`
+	// Social は SNS のユーザー名と個人サイト（NormalizeSocialHandles 済み）
+	Social SocialHandles `firestore:"social"`
+	// Status は在籍状況。空は導入前に登録されたメンバーで、active として扱う（EffectiveStatus を使うこと）
+	Status string `firestore:"status,omitempty"`
+	// StudentID は学籍番号（NormalizeStudentID 済み。API レスポンスには含めない）
+	StudentID string `firestore:"student_id,omitempty"`
+	// Tags はスキル・興味タグ（NormalizeTags 済み）
+	Tags []string `firestore:"tags,omitempty"`
+	// Visibility は他のメンバーに見せる項目の設定。Social の各項目はここが true のときだけ本人以外に見せる
+	Visibility Visibility `firestore:"visibility"`
+	// Year は以前の自由入力の学年（「2年生」など）。EnrollmentYear のないメンバーの表示にだけ使う
+	Year string `firestore:"year"`
+}
+
+// メンバーの在籍状況
+const (
+	StatusPending   = "pending"   // 本人が登録し、役員の承認待ち
+	StatusActive    = "active"    // 在籍中
+	StatusAlumni    = "alumni"    // 卒業生
+	StatusSuspended = "suspended" // 利用停止
+)
+
+// ValidStatus は s が在籍状況として正しいかを返す。
+func ValidStatus(s string) bool {
+	switch s {
+	case StatusPending, StatusActive, StatusAlumni, StatusSuspended:
+		return true
+	}
+	return false
+}
+
+// SetTeams は所属する班・役職を teams に置き換える（RoleIDs と表示用の Roles をまとめて設定する）。
+func (m *Member) SetTeams(teams []Team) {
+	m.RoleIDs = make([]string, 0, len(teams))
+	m.Roles = make([]string, 0, len(teams))
+	for _, t := range teams {
+		m.RoleIDs = append(m.RoleIDs, t.Id)
+		m.Roles = append(m.Roles, t.Name)
+	}
+}
+
+// renamedRoles は班・役職 teamID の表示名を name に置き換えた roles を返す。
+// roles は role_ids と同じ順に並んでいるので、名前ではなく同じ位置の ID で対応を取る。
+func (m *Member) renamedRoles(teamID, name string) []string {
+	roles := slices.Clone(m.Roles)
+	for i, rid := range m.RoleIDs {
+		if rid == teamID && i < len(roles) {
+			roles[i] = name
+		}
+	}
+	return roles
+}
+
+// PubliclyVisible は役員・本人以外にも見せてよいメンバー（在籍中・卒業生）かを返す。
+func (m *Member) PubliclyVisible() bool {
+	if m.MergedInto != "" || m.Deleted() {
+		return false
+	}
+	st := m.EffectiveStatus()
+	return st == StatusActive || st == StatusAlumni
+}
+
+// Deleted は削除済み（復元できる保持期間中）かを返す。
+func (m *Member) Deleted() bool {
+	return !m.DeletedAt.IsZero()
+}
+
+// EffectiveStatus は在籍状況を返す。status 導入前のメンバー（空）は active とみなす。
+func (m *Member) EffectiveStatus() string {
+	if m.Status == "" {
+		return StatusActive
+	}
+	return m.Status
+}
+
+// 連携先プロバイダ名。Member のフィールド名（Firestore のパス）と accounts のキーを兼ねる。
+const (
+	ProviderDiscord = "discord"
+	ProviderGitHub  = "github"
+	ProviderLINE    = "line"
+)
+
+// githubLinkTitle は GitHub 連携から自動で載せる links エントリのタイトル。
+const githubLinkTitle = "GitHub"
+
+// LinkedAccount は OAuth で連携した外部アカウント。
+type LinkedAccount struct {
+	ID       string    `firestore:"id"`
+	Username string    `firestore:"username"`
+	LinkedAt time.Time `firestore:"linked_at"`
+	// ProfileURL は連携先のプロフィール URL（GitHub のみ）
+	ProfileURL string `firestore:"profile_url,omitempty"`
+	// ShowOnProfile が true なら ProfileURL をメンバー詳細の links に自動で載せる
+	ShowOnProfile bool `firestore:"show_on_profile,omitempty"`
 }
 
 // ToSummary は Member を API レスポンス用の MemberSummary に変換する。
 func (m *Member) ToSummary() api.MemberSummary {
-	return api.MemberSummary{
+	s := api.MemberSummary{
 		Id:       m.Id,
 		Name:     m.Name,
 		Nickname: m.Nickname,
 		Roles:    m.Roles,
 		Avatar:   m.Avatar,
+		Status:   memberStatus(m.EffectiveStatus()),
+	}
+	if s.Roles == nil {
+		s.Roles = []string{}
+	}
+	if len(m.RoleIDs) > 0 {
+		s.RoleIds = &m.RoleIDs
 	}
+	if len(m.Tags) > 0 {
+		s.Tags = &m.Tags
+	}
+	return s
 }
 
-// ToDetail は Member を API レスポンス用の MemberDetail に変換する。
+// ToDetail は Member を API レスポンス用の MemberDetail に変換する。学年は呼び出し時点の年度で計算する。
 func (m *Member) ToDetail() api.MemberDetail {
+	now := time.Now()
 	detail := api.MemberDetail{
 		Id:         m.Id,
 		Name:       m.Name,
 		Nickname:   m.Nickname,
 		Department: m.Department,
-		Year:       m.Year,
+		Year:       m.DisplayYear(now),
 		Bio:        m.Bio,
 		Avatar:     m.Avatar,
 		Roles:      m.Roles,
+		Status:     memberStatus(m.EffectiveStatus()),
+	}
+	if len(m.RoleIDs) > 0 {
+		detail.RoleIds = &m.RoleIDs
 	}
-	detail.Accounts.Discord = m.Accounts.Discord
-	detail.Accounts.Github = m.Accounts.Github
+	if len(m.Tags) > 0 {
+		detail.Tags = &m.Tags
+	}
+	if m.EnrollmentYear != 0 {
+		enrollment, programYears := m.EnrollmentYear, m.ProgramYearsOrDefault()
+		detail.EnrollmentYear = &enrollment
+		detail.ProgramYears = &programYears
+		// 卒業生には学年を付けない（year 側に「2025年度卒」と出る）
+		if grade := m.GradeAt(now); grade > 0 && m.EffectiveStatus() != StatusAlumni {
+			detail.Grade = &grade
+		}
+	}
+	if m.Deleted() {
+		deletedAt := m.DeletedAt
+		detail.DeletedAt = &deletedAt
+	}
+	// 保存している bool は連携の解除などでずれることがあるので、連携情報があるかどうかで返す
+	detail.Accounts.Discord = m.Discord != nil
+	detail.Accounts.Github = m.GitHub != nil
 	detail.Accounts.Line = m.Accounts.Line
 
+	// links / events は必須の配列なので、空でも null ではなく [] を返す
+	detail.Links = make([]struct {
+		Title string `json:"title"`
+		Url   string `json:"url"`
+	}, 0, len(m.Links))
+	detail.Events = eventsToAPI(m.Events)
+	if detail.Roles == nil {
+		detail.Roles = []string{}
+	}
+
 	for _, l := range m.Links {
 		detail.Links = append(detail.Links, struct {
 			Title string `json:"title"`
 			Url   string `json:"url"`
 		}{Title: l.Title, Url: l.Url})
 	}
+	// GitHub 連携中は、本人が手で同じ URL を載せていなければプロフィール URL を links に加える。
+	// 保存せず毎回組み立てるので、連携を外せば自然に消える
+	if url := m.githubProfileURL(); url != "" && !m.hasLink(url) {
+		detail.Links = append(detail.Links, struct {
+			Title string `json:"title"`
+			Url   string `json:"url"`
+		}{Title: githubLinkTitle, Url: url})
+	}
 
 	return detail
 }
 
+func memberStatus(s string) *api.MemberStatus {
+	st := api.MemberStatus(s)
+	return &st
+}
+
+// githubProfileURL は links に自動で載せる GitHub のプロフィール URL を返す。載せない場合は空文字。
+func (m *Member) githubProfileURL() string {
+	if m.GitHub == nil || !m.GitHub.ShowOnProfile || m.GitHub.ProfileURL == "" {
+		return ""
+	}
+	return strings.TrimRight(m.GitHub.ProfileURL, "/")
+}
+
+// hasLink は links に同じ URL（末尾スラッシュ・大文字小文字は無視）が既にあるかを返す。
+func (m *Member) hasLink(url string) bool {
+	for _, l := range m.Links {
+		if strings.EqualFold(strings.TrimRight(l.Url, "/"), url) {
+			return true
+		}
+	}
+	return false
+}
+
 // MemberFromAPICreate は api.MemberCreate を Member に変換する。
 func MemberFromAPICreate(req api.MemberCreate) Member {
 	m := Member{
@@ -72,8 +267,16 @@ func MemberFromAPICreate(req api.MemberCreate) Member {
 		Roles:      req.Roles,
 		Avatar:     req.Avatar,
 	}
-	m.Accounts.Discord = req.Accounts.Discord
-	m.Accounts.Github = req.Accounts.Github
+	if req.EnrollmentYear != nil {
+		m.EnrollmentYear = *req.EnrollmentYear
+	}
+	if req.ProgramYears != nil {
+		m.ProgramYears = *req.ProgramYears
+	}
+	if req.StudentId != nil {
+		m.StudentID = NormalizeStudentID(*req.StudentId)
+	}
+	// Discord / GitHub は連携フロー（/api/me/links/...）でのみ true になるので、リクエストの値は使わない
 	m.Accounts.Line = req.Accounts.Line
 
 	if req.Links != nil {
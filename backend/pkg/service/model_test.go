@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDetail_GitHubProfileLink(t *testing.T) {
+	github := &LinkedAccount{ID: "583231", Username: "octocat", ProfileURL: "https://github.com/octocat", ShowOnProfile: true}
+
+	t.Run("adds the profile url while linked", func(t *testing.T) {
+		m := Member{GitHub: github}
+		m.Accounts.Github = true
+		d := m.ToDetail()
+		assert.Len(t, d.Links, 1)
+		assert.Equal(t, "GitHub", d.Links[0].Title)
+		assert.Equal(t, "https://github.com/octocat", d.Links[0].Url)
+	})
+
+	t.Run("does not duplicate a manual link", func(t *testing.T) {
+		m := Member{GitHub: github}
+		m.Links = append(m.Links, struct {
+			Title string `firestore:"title"`
+			Url   string `firestore:"url"`
+		}{Title: "My GitHub", Url: "https://GitHub.com/octocat/"})
+		d := m.ToDetail()
+		assert.Len(t, d.Links, 1)
+		assert.Equal(t, "My GitHub", d.Links[0].Title)
+	})
+
+	t.Run("hidden when opted out or unlinked", func(t *testing.T) {
+		hidden := *github
+		hidden.ShowOnProfile = false
+		assert.Empty(t, (&Member{GitHub: &hidden}).ToDetail().Links)
+		assert.Empty(t, (&Member{}).ToDetail().Links)
+	})
+}
+
+func TestPubliclyVisible_Deleted(t *testing.T) {
+	m := Member{Status: StatusActive}
+	assert.True(t, m.PubliclyVisible())
+	assert.Nil(t, m.ToDetail().DeletedAt)
+
+	m.DeletedAt = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	assert.True(t, m.Deleted())
+	assert.False(t, m.PubliclyVisible())
+	if d := m.ToDetail(); assert.NotNil(t, d.DeletedAt) {
+		assert.True(t, d.DeletedAt.Equal(m.DeletedAt))
+	}
+}
+
+func TestToDetail_Events(t *testing.T) {
+	// 保存されているイベントはそのまま返し、なければ null ではなく空の配列
+	assert.NotNil(t, (&Member{}).ToDetail().Events)
+	assert.Empty(t, (&Member{}).ToDetail().Events)
+
+	date := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	d := (&Member{Events: []Event{{Name: "新歓", Date: date, Status: "completed"}}}).ToDetail()
+	if assert.Len(t, d.Events, 1) {
+		assert.Equal(t, "新歓", d.Events[0].Name)
+		assert.True(t, d.Events[0].Date.Time.Equal(date))
+		assert.Equal(t, "completed", string(d.Events[0].Status))
+	}
+}
+
+func TestToDetail_AccountsFromLinks(t *testing.T) {
+	// accounts.discord / accounts.github が残っていても、連携情報がなければ未連携として返す
+	stale := Member{}
+	stale.Accounts.Discord = true
+	stale.Accounts.Github = true
+	assert.False(t, stale.ToDetail().Accounts.Discord)
+	assert.False(t, stale.ToDetail().Accounts.Github)
+
+	linked := Member{
+		Discord: &LinkedAccount{ID: "80351110224678912", Username: "tanataro"},
+		GitHub:  &LinkedAccount{ID: "583231", Username: "octocat"},
+	}
+	assert.True(t, linked.ToDetail().Accounts.Discord)
+	assert.True(t, linked.ToDetail().Accounts.Github)
+}
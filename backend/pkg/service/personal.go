@@ -0,0 +1,254 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErasedMemberName は退会したメンバーの任期の記録に残す名前
+const ErasedMemberName = "退会したメンバー"
+
+// PersonalData はメンバー本人について保存しているデータ（GET /api/me/export で本人に渡すもの）。
+type PersonalData struct {
+	Member Member
+	// Record は members のドキュメントをそのまま読んだもの（Member にない古いフィールドも含む）
+	Record     map[string]any
+	Identities []Identity
+	// Terms は本人の役職の任期（新しい順）
+	Terms []RoleTerm
+	// Invitations は本人が発行したか、本人が使って登録した招待コード
+	Invitations []Invitation
+}
+
+// AuditEntry は本人について記録している出来事。
+type AuditEntry struct {
+	At     time.Time `json:"at"`
+	Action string    `json:"action"`
+	// By は記録した（操作した）メンバーの ID
+	By     string `json:"by,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// 記録の種類
+const (
+	AuditMemberRegistered = "member_registered"
+	AuditIdentityLinked   = "identity_linked"
+	AuditRoleTermRecorded = "role_term_recorded"
+	AuditInvitationIssued = "invitation_created"
+	AuditInvitationUsed   = "invitation_redeemed"
+	AuditMemberDeleted    = "member_deleted"
+)
+
+// AuditLog は保存しているデータから本人についての記録を組み立てて古い順に返す。
+// 別に監査ログを持っているわけではなく、各コレクションの日時と記録者から作る。
+func (d *PersonalData) AuditLog() []AuditEntry {
+	out := make([]AuditEntry, 0)
+	add := func(at time.Time, action, by, detail string) {
+		if !at.IsZero() {
+			out = append(out, AuditEntry{At: at, Action: action, By: by, Detail: detail})
+		}
+	}
+	id := d.Member.Id
+	add(d.Member.CreatedAt, AuditMemberRegistered, "", "")
+	add(d.Member.DeletedAt, AuditMemberDeleted, "", "")
+	for _, idn := range d.Identities {
+		add(idn.CreatedAt, AuditIdentityLinked, "", idn.Provider)
+	}
+	for _, t := range d.Terms {
+		add(t.CreatedAt, AuditRoleTermRecorded, t.CreatedBy, t.Role)
+	}
+	for _, inv := range d.Invitations {
+		if inv.CreatedBy == id {
+			add(inv.CreatedAt, AuditInvitationIssued, id, inv.Code)
+		}
+		for _, r := range inv.Redemptions {
+			if r.MemberID == id {
+				add(r.RedeemedAt, AuditInvitationUsed, "", inv.Code)
+			}
+		}
+	}
+	slices.SortStableFunc(out, func(a, b AuditEntry) int {
+		return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.Action, b.Action))
+	})
+	return out
+}
+
+// PersonalInvitation は本人が発行したか、本人が使って登録した招待コード（GET /api/me/export の invitations.json）。
+// 他のメンバーの利用記録は含めない。
+type PersonalInvitation struct {
+	Code      string    `json:"code"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Roles     []string  `json:"roles,omitempty"`
+	Year      string    `json:"year,omitempty"`
+	// MaxUses・Uses・Note は本人が発行したものだけに入れる
+	MaxUses int    `json:"max_uses,omitempty"`
+	Uses    int    `json:"uses,omitempty"`
+	Note    string `json:"note,omitempty"`
+	// RedeemedAt は本人が使って登録した日時
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+}
+
+// InvitationRecords は Invitations を本人に渡す形にして、発行・利用の古い順に返す。
+func (d *PersonalData) InvitationRecords() []PersonalInvitation {
+	id := d.Member.Id
+	out := make([]PersonalInvitation, 0, len(d.Invitations))
+	for _, inv := range d.Invitations {
+		p := PersonalInvitation{
+			Code: inv.Code, CreatedBy: inv.CreatedBy, CreatedAt: inv.CreatedAt, ExpiresAt: inv.ExpiresAt,
+			Roles: inv.Roles, Year: inv.Year,
+		}
+		if inv.CreatedBy == id {
+			p.MaxUses, p.Uses, p.Note = inv.MaxUses, inv.Uses, inv.Note
+		}
+		for _, r := range inv.Redemptions {
+			if r.MemberID == id {
+				p.RedeemedAt = &r.RedeemedAt
+			}
+		}
+		out = append(out, p)
+	}
+	slices.SortStableFunc(out, func(a, b PersonalInvitation) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return out
+}
+
+// PersonalData は id のメンバーについて保存しているデータを集める。見つからなければ ErrMemberNotFound。
+// 統合したメンバーのログイン ID・任期は統合先に付け替えてあるので、統合先の本人のものとして含まれる。
+func (s *MembersService) PersonalData(ctx context.Context, id string) (*PersonalData, error) {
+	ctx, span := tracer.Start(ctx, "MembersService.PersonalData", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	// --- ① メンバーのドキュメント ---
+	doc, err := s.fs.Collection(membersCollection).Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(membersCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(membersCollection, 1)
+	d := &PersonalData{Record: doc.Data()}
+	if err := doc.DataTo(&d.Member); err != nil {
+		return nil, endSpan(span, err)
+	}
+	if d.Member.Id == "" {
+		d.Member.Id = id
+	}
+
+	// --- ② ログイン ID と任期 ---
+	docs, err := s.fs.Collection(identitiesCollection).Where("member_id", "==", id).Documents(ctx).GetAll()
+	if err != nil {
+		metrics.FirestoreError(identitiesCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(identitiesCollection, len(docs))
+	for _, doc := range docs {
+		var idn Identity
+		if err := doc.DataTo(&idn); err != nil {
+			return nil, endSpan(span, err)
+		}
+		d.Identities = append(d.Identities, idn)
+	}
+	if d.Terms, err = NewTermsService(s.fs).ListByMember(ctx, id); err != nil {
+		return nil, endSpan(span, err)
+	}
+
+	// --- ③ 招待コード（利用記録は配列の中にあって絞り込めないので、全件から探す） ---
+	invitations, err := NewInvitationsService(s.fs).List(ctx)
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	for _, inv := range invitations {
+		used := slices.ContainsFunc(inv.Redemptions, func(r Redemption) bool { return r.MemberID == id })
+		if inv.CreatedBy == id || used {
+			d.Invitations = append(d.Invitations, inv)
+		}
+	}
+	return d, nil
+}
+
+// Erase は退会したメンバーのデータを消す。すべて 1 つのトランザクションで行う。
+//   - members のドキュメントと、このメンバーに統合済みのメンバーの跡を削除する
+//   - ログイン ID（member_identities）を削除する（同じ LINE などで新しく登録し直せる）
+//   - 役職の任期（role_terms）は歴代の記録として残し、名前を ErasedMemberName に置き換える
+//
+// 招待コードの発行者・利用記録と、任期の記録者に残るのはメンバー ID だけなのでそのままにする。
+// 見つからなければ ErrMemberNotFound。
+func (s *MembersService) Erase(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "MembersService.Erase", trace.WithAttributes(attribute.String("member.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(membersCollection).Doc(id)
+	var writes map[string]int
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		writes = map[string]int{membersCollection: 1}
+		if _, err := getMemberTx(tx, ref); err != nil {
+			return err
+		}
+
+		// --- ① 消すドキュメントと名前を置き換えるドキュメントを読む ---
+		query := func(col, path string) ([]*firestore.DocumentSnapshot, error) {
+			docs, err := tx.Documents(s.fs.Collection(col).Where(path, "==", id)).GetAll()
+			if err != nil {
+				metrics.FirestoreError(col, "read")
+				return nil, err
+			}
+			metrics.FirestoreRead(col, len(docs))
+			return docs, nil
+		}
+		merged, err := query(membersCollection, "merged_into")
+		if err != nil {
+			return err
+		}
+		identities, err := query(identitiesCollection, "member_id")
+		if err != nil {
+			return err
+		}
+		terms, err := query(roleTermsCollection, "member_id")
+		if err != nil {
+			return err
+		}
+
+		// --- ② 書き込み ---
+		if err := tx.Delete(ref); err != nil {
+			return err
+		}
+		for _, doc := range append(merged, identities...) {
+			if err := tx.Delete(doc.Ref); err != nil {
+				return err
+			}
+			writes[doc.Ref.Parent.ID]++
+		}
+		for _, doc := range terms {
+			if err := tx.Update(doc.Ref, []firestore.Update{{Path: "member_name", Value: ErasedMemberName}}); err != nil {
+				return err
+			}
+			writes[roleTermsCollection]++
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrMemberNotFound) {
+			metrics.FirestoreError(membersCollection, "write")
+		}
+		return endSpan(span, err)
+	}
+	for col, n := range writes {
+		metrics.FirestoreWrite(col, n)
+	}
+	return nil
+}
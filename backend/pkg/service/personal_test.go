@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPersonalData_AuditLog(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 4, d, 0, 0, 0, 0, time.UTC) }
+	d := PersonalData{
+		Member:     Member{Id: "m1", CreatedAt: day(2)},
+		Identities: []Identity{{Provider: "line", MemberID: "m1", CreatedAt: day(2)}},
+		Terms:      []RoleTerm{{MemberID: "m1", Role: "代表", CreatedBy: "m-officer", CreatedAt: day(10)}},
+		Invitations: []Invitation{
+			{Code: "ABCD", CreatedBy: "m-officer", CreatedAt: day(1), Redemptions: []Redemption{
+				{MemberID: "m1", RedeemedAt: day(2)},
+				{MemberID: "m2", RedeemedAt: day(3)},
+			}},
+			{Code: "EFGH", CreatedBy: "m1", CreatedAt: day(20)},
+		},
+	}
+
+	got := d.AuditLog()
+	want := []AuditEntry{
+		{At: day(2), Action: AuditIdentityLinked, Detail: "line"},
+		{At: day(2), Action: AuditInvitationUsed, Detail: "ABCD"},
+		{At: day(2), Action: AuditMemberRegistered},
+		{At: day(10), Action: AuditRoleTermRecorded, By: "m-officer", Detail: "代表"},
+		{At: day(20), Action: AuditInvitationIssued, By: "m1", Detail: "EFGH"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), got)
+	}
+	for i := range want {
+		if !got[i].At.Equal(want[i].At) || got[i].Action != want[i].Action || got[i].By != want[i].By || got[i].Detail != want[i].Detail {
+			t.Fatalf("entry %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestPersonalData_InvitationRecords(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 4, d, 0, 0, 0, 0, time.UTC) }
+	d := PersonalData{
+		Member: Member{Id: "m1"},
+		Invitations: []Invitation{
+			{Code: "EFGH", CreatedBy: "m1", CreatedAt: day(20), MaxUses: 5, Uses: 1, Note: "新歓", Redemptions: []Redemption{
+				{MemberID: "m3", RedeemedAt: day(21)},
+			}},
+			{Code: "ABCD", CreatedBy: "m-officer", CreatedAt: day(1), MaxUses: 30, Uses: 2, Note: "1年生向け", Redemptions: []Redemption{
+				{MemberID: "m1", RedeemedAt: day(2)},
+				{MemberID: "m2", RedeemedAt: day(3)},
+			}},
+		},
+	}
+
+	got := d.InvitationRecords()
+	if len(got) != 2 || got[0].Code != "ABCD" || got[1].Code != "EFGH" {
+		t.Fatalf("unexpected invitations: %+v", got)
+	}
+	// 使って登録した招待コードは、本人の利用日時だけで発行者のメモや利用回数は含めない
+	if got[0].RedeemedAt == nil || !got[0].RedeemedAt.Equal(day(2)) || got[0].Note != "" || got[0].Uses != 0 {
+		t.Fatalf("unexpected redeemed invitation: %+v", got[0])
+	}
+	// 発行した招待コードは利用回数を含むが、使ったメンバーは含めない
+	if got[1].RedeemedAt != nil || got[1].Uses != 1 || got[1].MaxUses != 5 || got[1].Note != "新歓" {
+		t.Fatalf("unexpected issued invitation: %+v", got[1])
+	}
+}
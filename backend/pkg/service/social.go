@@ -0,0 +1,198 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+)
+
+// ErrInvalidHandle は SNS のユーザー名・URL の形式が正しくないことを表す。
+var ErrInvalidHandle = errors.New("invalid social handle")
+
+// SocialHandles は SNS のユーザー名と個人サイト。NormalizeSocialHandles 済みの値を保存する（空は未登録）。
+type SocialHandles struct {
+	X         string `firestore:"x,omitempty"`
+	Instagram string `firestore:"instagram,omitempty"`
+	GitHub    string `firestore:"github,omitempty"`
+	Website   string `firestore:"website,omitempty"`
+}
+
+// Visibility は他のメンバーに見せる項目の設定。false（未設定）は非公開。
+type Visibility struct {
+	Name             bool `firestore:"name"`
+	SelfIntroduction bool `firestore:"self_introduction"`
+	X                bool `firestore:"x"`
+	Instagram        bool `firestore:"instagram"`
+	GitHub           bool `firestore:"github"`
+	Website          bool `firestore:"website"`
+}
+
+var (
+	xHandlePattern         = regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`)
+	instagramHandlePattern = regexp.MustCompile(`^[a-z0-9._]{1,30}$`)
+	githubHandlePattern    = regexp.MustCompile(`^[A-Za-z0-9-]{1,39}$`)
+)
+
+// handleFromInput は「@name」「name」「https://x.com/name」のどれで入力されてもユーザー名を取り出す。
+// hosts は URL で入力されたときに受け付けるホスト名。
+func handleFromInput(in string, hosts ...string) string {
+	s := strings.TrimSpace(in)
+	lower := strings.ToLower(s)
+	for _, host := range hosts {
+		for _, prefix := range []string{"https://", "http://", ""} {
+			for _, www := range []string{"www.", ""} {
+				if p := prefix + www + host + "/"; strings.HasPrefix(lower, p) {
+					s = s[len(p):]
+					// クエリやサブパス（/status/... など）は捨てる
+					if i := strings.IndexAny(s, "/?#"); i >= 0 {
+						s = s[:i]
+					}
+					return s
+				}
+			}
+		}
+	}
+	return strings.TrimPrefix(s, "@")
+}
+
+// NormalizeXHandle は X（旧 Twitter）のユーザー名を返す。英数字と _ の 15 文字まで。
+func NormalizeXHandle(in string) (string, error) {
+	h := handleFromInput(in, "x.com", "twitter.com", "mobile.twitter.com")
+	if !xHandlePattern.MatchString(h) {
+		return "", fmt.Errorf("%w: x %q", ErrInvalidHandle, in)
+	}
+	return h, nil
+}
+
+// NormalizeInstagramHandle は Instagram のユーザー名を小文字で返す。英数字と . _ の 30 文字まで（. は先頭・末尾・連続不可）。
+func NormalizeInstagramHandle(in string) (string, error) {
+	h := strings.ToLower(handleFromInput(in, "instagram.com"))
+	if !instagramHandlePattern.MatchString(h) || strings.HasPrefix(h, ".") || strings.HasSuffix(h, ".") || strings.Contains(h, "..") {
+		return "", fmt.Errorf("%w: instagram %q", ErrInvalidHandle, in)
+	}
+	return h, nil
+}
+
+// NormalizeGitHubHandle は GitHub のユーザー名を返す。英数字と - の 39 文字まで（- は先頭・末尾・連続不可）。
+func NormalizeGitHubHandle(in string) (string, error) {
+	h := handleFromInput(in, "github.com")
+	if !githubHandlePattern.MatchString(h) || strings.HasPrefix(h, "-") || strings.HasSuffix(h, "-") || strings.Contains(h, "--") {
+		return "", fmt.Errorf("%w: github %q", ErrInvalidHandle, in)
+	}
+	return h, nil
+}
+
+// NormalizeWebsite は個人サイトの URL を返す。スキームがなければ https:// を補い、ホスト名は小文字にする。
+func NormalizeWebsite(in string) (string, error) {
+	s := strings.TrimSpace(in)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" || !strings.Contains(u.Hostname(), ".") || u.User != nil {
+		return "", fmt.Errorf("%w: website %q", ErrInvalidHandle, in)
+	}
+	u.Host = strings.ToLower(u.Host)
+	return u.String(), nil
+}
+
+// NormalizeSocialHandles は空でない項目をそれぞれ正規化する。1 つでも形式が正しくなければ ErrInvalidHandle。
+func NormalizeSocialHandles(in SocialHandles) (SocialHandles, error) {
+	var out SocialHandles
+	for _, f := range []struct {
+		in        string
+		out       *string
+		normalize func(string) (string, error)
+	}{
+		{in.X, &out.X, NormalizeXHandle},
+		{in.Instagram, &out.Instagram, NormalizeInstagramHandle},
+		{in.GitHub, &out.GitHub, NormalizeGitHubHandle},
+		{in.Website, &out.Website, NormalizeWebsite},
+	} {
+		if strings.TrimSpace(f.in) == "" {
+			continue
+		}
+		v, err := f.normalize(f.in)
+		if err != nil {
+			return SocialHandles{}, err
+		}
+		*f.out = v
+	}
+	return out, nil
+}
+
+// SocialHandlesFromAPI は api.SocialHandles を SocialHandles に変換する（正規化はしない）。
+func SocialHandlesFromAPI(in api.SocialHandles) SocialHandles {
+	deref := func(p *string) string {
+		if p == nil {
+			return ""
+		}
+		return *p
+	}
+	return SocialHandles{X: deref(in.X), Instagram: deref(in.Instagram), GitHub: deref(in.Github), Website: deref(in.Website)}
+}
+
+// ToAPI は SocialHandles を api.SocialHandles に変換する。未登録の項目は省略する。
+func (s SocialHandles) ToAPI() api.SocialHandles {
+	ptr := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	return api.SocialHandles{X: ptr(s.X), Instagram: ptr(s.Instagram), Github: ptr(s.GitHub), Website: ptr(s.Website)}
+}
+
+// VisibilityFromAPI は api.Visibility を Visibility に変換する。github / website の省略は非公開。
+func VisibilityFromAPI(in api.Visibility) Visibility {
+	v := Visibility{Name: in.Name, SelfIntroduction: in.SelfIntroduction, X: in.X, Instagram: in.Instagram}
+	if in.Github != nil {
+		v.GitHub = *in.Github
+	}
+	if in.Website != nil {
+		v.Website = *in.Website
+	}
+	return v
+}
+
+// ToAPI は Visibility を api.Visibility に変換する。
+func (v Visibility) ToAPI() api.Visibility {
+	return api.Visibility{
+		Name:             v.Name,
+		SelfIntroduction: v.SelfIntroduction,
+		X:                v.X,
+		Instagram:        v.Instagram,
+		Github:           &v.GitHub,
+		Website:          &v.Website,
+	}
+}
+
+// SocialLinks はメンバー詳細に載せる SNS アカウントを URL 付きで返す。
+// self（本人が見ている）でなければ Visibility が true の項目だけを含める。
+func (m *Member) SocialLinks(self bool) api.MemberSocial {
+	var out api.MemberSocial
+	account := func(handle, link string) *api.SocialAccount {
+		return &api.SocialAccount{Handle: handle, Url: link}
+	}
+	if h := m.Social.X; h != "" && (self || m.Visibility.X) {
+		out.X = account(h, "https://x.com/"+h)
+	}
+	if h := m.Social.Instagram; h != "" && (self || m.Visibility.Instagram) {
+		out.Instagram = account(h, "https://www.instagram.com/"+h+"/")
+	}
+	if h := m.Social.GitHub; h != "" && (self || m.Visibility.GitHub) {
+		out.Github = account(h, "https://github.com/"+h)
+	}
+	if site := m.Social.Website; site != "" && (self || m.Visibility.Website) {
+		host := site
+		if u, err := url.Parse(site); err == nil {
+			host = u.Host
+		}
+		out.Website = account(host, site)
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeSocialHandles(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      SocialHandles
+		want    SocialHandles
+		wantErr bool
+	}{
+		{
+			name: "handles and urls",
+			in: SocialHandles{
+				X:         "@lumos_dev",
+				Instagram: "https://www.instagram.com/Lumos.Photo/?hl=ja",
+				GitHub:    "github.com/Lumos-Programming",
+				Website:   "Example.COM/blog",
+			},
+			want: SocialHandles{
+				X:         "lumos_dev",
+				Instagram: "lumos.photo",
+				GitHub:    "Lumos-Programming",
+				Website:   "https://example.com/blog",
+			},
+		},
+		{name: "twitter url", in: SocialHandles{X: "https://twitter.com/lumos/status/1"}, want: SocialHandles{X: "lumos"}},
+		{name: "blank fields are skipped", in: SocialHandles{X: "  "}, want: SocialHandles{}},
+		{name: "x too long", in: SocialHandles{X: "abcdefghijklmnop"}, wantErr: true},
+		{name: "x other site", in: SocialHandles{X: "https://example.com/lumos"}, wantErr: true},
+		{name: "instagram double dot", in: SocialHandles{Instagram: "lumos..photo"}, wantErr: true},
+		{name: "github trailing hyphen", in: SocialHandles{GitHub: "lumos-"}, wantErr: true},
+		{name: "website scheme", in: SocialHandles{Website: "javascript:alert(1)"}, wantErr: true},
+		{name: "website without host", in: SocialHandles{Website: "https://"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeSocialHandles(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidHandle) {
+					t.Fatalf("expected ErrInvalidHandle, got %v (%+v)", err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSocialLinks(t *testing.T) {
+	m := Member{
+		Social:     SocialHandles{X: "lumos", Instagram: "lumos.photo", GitHub: "lumos-dev", Website: "https://example.com/"},
+		Visibility: Visibility{X: true, Website: true},
+	}
+
+	public := m.SocialLinks(false)
+	if public.X == nil || public.X.Url != "https://x.com/lumos" {
+		t.Fatalf("x should be visible: %+v", public.X)
+	}
+	if public.Instagram != nil || public.Github != nil {
+		t.Fatalf("private accounts should be hidden: %+v", public)
+	}
+	if public.Website == nil || public.Website.Handle != "example.com" || public.Website.Url != "https://example.com/" {
+		t.Fatalf("unexpected website: %+v", public.Website)
+	}
+
+	self := m.SocialLinks(true)
+	if self.Instagram == nil || self.Instagram.Url != "https://www.instagram.com/lumos.photo/" {
+		t.Fatalf("the member should see their own instagram: %+v", self.Instagram)
+	}
+	if self.Github == nil || self.Github.Url != "https://github.com/lumos-dev" {
+		t.Fatalf("the member should see their own github: %+v", self.Github)
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxTags は 1 人が付けられるタグの数
+	MaxTags = 20
+	// maxTagLength はタグ 1 つの長さの上限（文字数）
+	maxTagLength = 30
+)
+
+// ErrInvalidTag はタグが空・長すぎる・多すぎることを表す。
+var ErrInvalidTag = errors.New("invalid tag")
+
+// tagAliases はよく使われる略称・別表記を代表の表記にそろえる（キーも値も NormalizeTag 済みの形）。
+var tagAliases = map[string]string{
+	"js":               "javascript",
+	"ts":               "typescript",
+	"py":               "python",
+	"golang":           "go",
+	"ml":               "機械学習",
+	"machine learning": "機械学習",
+	"ai":               "人工知能",
+	"cg":               "3dcg",
+	"ui/ux":            "uiux",
+	"ux/ui":            "uiux",
+	"競プロ":              "競技プログラミング",
+	"web開発":            "web アプリ開発",
+	"webアプリ開発":         "web アプリ開発",
+	"ゲーム開発":            "ゲーム制作",
+}
+
+// NormalizeTag はタグの表記をそろえる。NFKC（全角英数→半角）、小文字化、前後と連続する空白の除去をしたうえで、
+// tagAliases の別表記を代表の表記に置き換える。「ＰＹＴＨＯＮ」「py」はどちらも「python」になる。
+func NormalizeTag(tag string) string {
+	t := strings.Join(strings.Fields(strings.ToLower(norm.NFKC.String(tag))), " ")
+	if alias, ok := tagAliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+// NormalizeTags はタグをそれぞれ NormalizeTag し、重複を除いて返す。
+// 空のタグ、長すぎるタグ、MaxTags を超える数は ErrInvalidTag。
+func NormalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		t := NormalizeTag(tag)
+		if t == "" {
+			return nil, fmt.Errorf("%w: empty tag", ErrInvalidTag)
+		}
+		if len([]rune(t)) > maxTagLength {
+			return nil, fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidTag, tag, maxTagLength)
+		}
+		if !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	if len(out) > MaxTags {
+		return nil, fmt.Errorf("%w: at most %d tags", ErrInvalidTag, MaxTags)
+	}
+	return out, nil
+}
+
+// HasTags は tags（NormalizeTag 済み）をすべて持っているかを返す。
+func (m *Member) HasTags(tags []string) bool {
+	for _, t := range tags {
+		if !slices.Contains(m.Tags, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// TagCount はタグと、そのタグを持つメンバー数。
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// CountTags は members のタグごとの人数を、多い順（同数ならタグ名順）に返す。
+func CountTags(members []Member) []TagCount {
+	counts := map[string]int{}
+	for _, m := range members {
+		for _, t := range m.Tags {
+			counts[t]++
+		}
+	}
+	out := make([]TagCount, 0, len(counts))
+	for t, n := range counts {
+		out = append(out, TagCount{Tag: t, Count: n})
+	}
+	slices.SortFunc(out, func(a, b TagCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return out
+}
+
+// SimilarMember は興味の近いメンバーと、その近さ。
+type SimilarMember struct {
+	Member     Member
+	Score      float64
+	SharedTags []string
+}
+
+// FindSimilar は target とタグの重なりが大きい順に candidates を最大 limit 件返す（target 自身は除く）。
+// 近さは target のタグのうち相手も持っている割合（0〜1）で、持っている人の少ないタグほど重く数える（IDF で重み付け）。
+// 相手がほかに多くのタグを持っていても下げない（興味の広い先輩がメンター候補から外れないように）。
+// 重み付けに使う人数は target と candidates から数える。
+func FindSimilar(target Member, candidates []Member, limit int) []SimilarMember {
+	if len(target.Tags) == 0 {
+		return []SimilarMember{}
+	}
+
+	// タグごとの重み：log(1 + N / そのタグを持つ人数)
+	population := 1
+	df := map[string]int{}
+	for _, t := range target.Tags {
+		df[t]++
+	}
+	for _, m := range candidates {
+		if m.Id == target.Id {
+			continue
+		}
+		population++
+		for _, t := range m.Tags {
+			df[t]++
+		}
+	}
+	weight := func(t string) float64 {
+		return math.Log1p(float64(population) / float64(max(df[t], 1)))
+	}
+	sum := func(tags []string) float64 {
+		var s float64
+		for _, t := range tags {
+			s += weight(t)
+		}
+		return s
+	}
+	targetWeight := sum(target.Tags)
+
+	out := make([]SimilarMember, 0)
+	for _, m := range candidates {
+		if m.Id == target.Id {
+			continue
+		}
+		shared := make([]string, 0)
+		for _, t := range target.Tags {
+			if slices.Contains(m.Tags, t) {
+				shared = append(shared, t)
+			}
+		}
+		if len(shared) == 0 {
+			continue
+		}
+		out = append(out, SimilarMember{
+			Member:     m,
+			Score:      sum(shared) / targetWeight,
+			SharedTags: shared,
+		})
+	}
+	slices.SortStableFunc(out, func(a, b SimilarMember) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(len(b.SharedTags), len(a.SharedTags)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Member.Name, b.Member.Name)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
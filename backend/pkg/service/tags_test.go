@@ -0,0 +1,59 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{"ＰＹＴＨＯＮ", "py", " Web  アプリ開発 ", "機械学習", "ML"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"python", "web アプリ開発", "機械学習"}, tags)
+
+	_, err = NormalizeTags([]string{" "})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	_, err = NormalizeTags([]string{strings.Repeat("あ", 31)})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	many := make([]string, 0, MaxTags+1)
+	for i := range MaxTags + 1 {
+		many = append(many, strings.Repeat("a", i+1))
+	}
+	_, err = NormalizeTags(many)
+	assert.ErrorIs(t, err, ErrInvalidTag)
+}
+
+func TestCountTags(t *testing.T) {
+	counts := CountTags([]Member{
+		{Tags: []string{"python", "機械学習"}},
+		{Tags: []string{"python"}},
+		{Tags: []string{"go"}},
+	})
+	assert.Equal(t, []TagCount{{"python", 2}, {"go", 1}, {"機械学習", 1}}, counts)
+}
+
+func TestFindSimilar(t *testing.T) {
+	target := Member{Id: "me", Tags: []string{"python", "機械学習"}}
+	candidates := []Member{
+		target,
+		{Id: "a", Name: "A", Tags: []string{"python"}},
+		{Id: "b", Name: "B", Tags: []string{"機械学習"}},
+		{Id: "c", Name: "C", Tags: []string{"python", "機械学習", "go"}},
+		{Id: "d", Name: "D", Tags: []string{"python"}},
+		{Id: "e", Name: "E", Tags: []string{"ゲーム制作"}},
+	}
+
+	got := FindSimilar(target, candidates, 10)
+	ids := make([]string, 0, len(got))
+	for _, s := range got {
+		ids = append(ids, s.Member.Id)
+	}
+	// 両方のタグが重なる c が最初。持つ人の少ない「機械学習」だけの b は「python」だけの a・d より近い
+	assert.Equal(t, []string{"c", "b", "a", "d"}, ids)
+	assert.Equal(t, []string{"python", "機械学習"}, got[0].SharedTags)
+	assert.True(t, got[0].Score > 0 && got[0].Score <= 1)
+
+	assert.Len(t, FindSimilar(target, candidates, 2), 2)
+	assert.Empty(t, FindSimilar(Member{Id: "x"}, candidates, 10))
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const teamsCollection = "teams"
+
+// 班・役職の種類
+const (
+	TeamKindTeam    = "team"    // 班（Web班など）
+	TeamKindOfficer = "officer" // 役職（代表・会計など）
+)
+
+var (
+	// ErrTeamNotFound は指定IDの班・役職が存在しないことを表す。
+	ErrTeamNotFound = errors.New("team not found")
+	// ErrTeamNameTaken は同じ名前（表記ゆれを含む）の班・役職がすでにあることを表す。
+	ErrTeamNameTaken = errors.New("team name is already taken")
+	// ErrTeamInUse は所属メンバーがいるため削除できないことを表す。
+	ErrTeamInUse = errors.New("team still has members")
+	// ErrUnknownTeam はメンバーに付けようとした班・役職が登録されていないことを表す。
+	ErrUnknownTeam = errors.New("unknown team")
+)
+
+// Team は "teams" コレクションの班・役職。メンバーは RoleIDs でこれを参照する。
+type Team struct {
+	Id        string    `firestore:"-"`
+	Name      string    `firestore:"name"`
+	Key       string    `firestore:"key"` // 重複確認用に NormalizeTeamName した名前
+	Kind      string    `firestore:"kind"`
+	Order     int       `firestore:"order"`
+	CreatedAt time.Time `firestore:"created_at"`
+}
+
+// ValidTeamKind は kind が定義済みの種類かを返す。
+func ValidTeamKind(kind string) bool {
+	return kind == TeamKindTeam || kind == TeamKindOfficer
+}
+
+// NormalizeTeamName は全角・半角、大文字・小文字、前後や連続する空白の違いをなくした名前を返す。
+// 「Ｗｅｂ班」「web班」「 Web班 」はすべて同じ班として扱う。
+func NormalizeTeamName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFKC.String(name))), " ")
+}
+
+// ToAPI は Team を API レスポンス用に変換する。
+func (t *Team) ToAPI() api.Team {
+	return api.Team{
+		Id:    t.Id,
+		Name:  t.Name,
+		Kind:  api.TeamKind(t.Kind),
+		Order: t.Order,
+	}
+}
+
+// SortTeams は表示順、同じなら名前順に並べる。
+func SortTeams(teams []Team) {
+	slices.SortStableFunc(teams, func(a, b Team) int {
+		if a.Order != b.Order {
+			return a.Order - b.Order
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// ResolveTeams は班・役職の名前か ID の並びを teams から探して返す。
+// 名前は NormalizeTeamName で比較し、同じ班の重複は 1 つにまとめる。見つからないものがあれば ErrUnknownTeam。
+func ResolveTeams(teams []Team, refs []string) ([]Team, error) {
+	byID := make(map[string]Team, len(teams))
+	byKey := make(map[string]Team, len(teams))
+	for _, t := range teams {
+		byID[t.Id] = t
+		byKey[NormalizeTeamName(t.Name)] = t
+	}
+
+	out := make([]Team, 0, len(refs))
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		t, ok := byID[ref]
+		if !ok {
+			t, ok = byKey[NormalizeTeamName(ref)]
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTeam, ref)
+		}
+		if seen[t.Id] {
+			continue
+		}
+		seen[t.Id] = true
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// TeamsService は Firestore の "teams" コレクションに対する操作を提供する。
+type TeamsService struct {
+	fs *firestore.Client
+}
+
+// NewTeamsService は TeamsService を生成する。
+func NewTeamsService(fs *firestore.Client) *TeamsService {
+	return &TeamsService{fs: fs}
+}
+
+// List は全ての班・役職を表示順に返す。
+func (s *TeamsService) List(ctx context.Context) ([]Team, error) {
+	ctx, span := tracer.Start(ctx, "TeamsService.List")
+	defer span.End()
+
+	docs, err := s.fs.Collection(teamsCollection).Documents(ctx).GetAll()
+	if err != nil {
+		metrics.FirestoreError(teamsCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(teamsCollection, len(docs))
+
+	out := make([]Team, 0, len(docs))
+	for _, doc := range docs {
+		var t Team
+		if err := doc.DataTo(&t); err != nil {
+			return nil, endSpan(span, err)
+		}
+		t.Id = doc.Ref.ID
+		out = append(out, t)
+	}
+	SortTeams(out)
+	return out, nil
+}
+
+// Get は指定IDの班・役職を返す。存在しない場合は ErrTeamNotFound。
+func (s *TeamsService) Get(ctx context.Context, id string) (*Team, error) {
+	ctx, span := tracer.Start(ctx, "TeamsService.Get", trace.WithAttributes(attribute.String("team.id", id)))
+	defer span.End()
+
+	doc, err := s.fs.Collection(teamsCollection).Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrTeamNotFound
+	}
+	if err != nil {
+		metrics.FirestoreError(teamsCollection, "read")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreRead(teamsCollection, 1)
+
+	var t Team
+	if err := doc.DataTo(&t); err != nil {
+		return nil, endSpan(span, err)
+	}
+	t.Id = doc.Ref.ID
+	return &t, nil
+}
+
+// Resolve は班・役職の名前か ID の並びを、登録済みの班・役職に解決する（ResolveTeams を参照）。
+func (s *TeamsService) Resolve(ctx context.Context, refs []string) ([]Team, error) {
+	if len(refs) == 0 {
+		return []Team{}, nil
+	}
+	teams, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveTeams(teams, refs)
+}
+
+// Create は班・役職を追加する。同じ名前のものがあれば ErrTeamNameTaken。
+func (s *TeamsService) Create(ctx context.Context, t Team) (*Team, error) {
+	ctx, span := tracer.Start(ctx, "TeamsService.Create")
+	defer span.End()
+
+	t.Key = NormalizeTeamName(t.Name)
+	t.CreatedAt = time.Now()
+	ref := s.fs.Collection(teamsCollection).NewDoc()
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := s.checkNameFree(tx, t.Key, ""); err != nil {
+			return err
+		}
+		return tx.Create(ref, t)
+	})
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreWrite(teamsCollection, 1)
+	t.Id = ref.ID
+	return &t, nil
+}
+
+// Update は班・役職の名前・種類・表示順を更新する。
+// 名前が変わった場合は、所属メンバーの roles（表示用の名前）も同じトランザクションで書き換える。
+func (s *TeamsService) Update(ctx context.Context, id string, in Team) (*Team, error) {
+	ctx, span := tracer.Start(ctx, "TeamsService.Update", trace.WithAttributes(attribute.String("team.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(teamsCollection).Doc(id)
+	members := s.fs.Collection(membersCollection).Where("role_ids", "array-contains", id)
+	var updated Team
+	err := s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// --- ① 読み込み（トランザクションでは書き込みより前にまとめて読む） ---
+		doc, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return ErrTeamNotFound
+		}
+		if err != nil {
+			metrics.FirestoreError(teamsCollection, "read")
+			return err
+		}
+		metrics.FirestoreRead(teamsCollection, 1)
+		var cur Team
+		if err := doc.DataTo(&cur); err != nil {
+			return err
+		}
+		key := NormalizeTeamName(in.Name)
+		if err := s.checkNameFree(tx, key, id); err != nil {
+			return err
+		}
+		var memberDocs []*firestore.DocumentSnapshot
+		if in.Name != cur.Name {
+			if memberDocs, err = tx.Documents(members).GetAll(); err != nil {
+				metrics.FirestoreError(membersCollection, "read")
+				return err
+			}
+			metrics.FirestoreRead(membersCollection, len(memberDocs))
+		}
+
+		// --- ② 書き込み ---
+		updated = cur
+		updated.Id = id
+		updated.Name, updated.Key, updated.Kind, updated.Order = in.Name, key, in.Kind, in.Order
+		if err := tx.Set(ref, updated); err != nil {
+			return err
+		}
+		for _, d := range memberDocs {
+			var m Member
+			if err := d.DataTo(&m); err != nil {
+				return err
+			}
+			if err := tx.Update(d.Ref, []firestore.Update{{Path: "roles", Value: m.renamedRoles(id, in.Name)}}); err != nil {
+				return err
+			}
+		}
+		metrics.FirestoreWrite(teamsCollection, 1)
+		metrics.FirestoreWrite(membersCollection, len(memberDocs))
+		return nil
+	})
+	if err != nil {
+		return nil, endSpan(span, err)
+	}
+	return &updated, nil
+}
+
+// Delete は班・役職を削除する。所属メンバーがいる場合は ErrTeamInUse。
+func (s *TeamsService) Delete(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "TeamsService.Delete", trace.WithAttributes(attribute.String("team.id", id)))
+	defer span.End()
+
+	ref := s.fs.Collection(teamsCollection).Doc(id)
+	members := s.fs.Collection(membersCollection).Where("role_ids", "array-contains", id).Limit(1)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if _, err := tx.Get(ref); status.Code(err) == codes.NotFound {
+			return ErrTeamNotFound
+		} else if err != nil {
+			metrics.FirestoreError(teamsCollection, "read")
+			return err
+		}
+		docs, err := tx.Documents(members).GetAll()
+		if err != nil {
+			metrics.FirestoreError(membersCollection, "read")
+			return err
+		}
+		if len(docs) > 0 {
+			return ErrTeamInUse
+		}
+		if err := tx.Delete(ref); err != nil {
+			metrics.FirestoreError(teamsCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(teamsCollection, 1)
+		return nil
+	}))
+}
+
+// checkNameFree は key（正規化した名前）の班・役職が id 以外にないことを確認する。
+func (s *TeamsService) checkNameFree(tx *firestore.Transaction, key, id string) error {
+	docs, err := tx.Documents(s.fs.Collection(teamsCollection).Where("key", "==", key)).GetAll()
+	if err != nil {
+		metrics.FirestoreError(teamsCollection, "read")
+		return err
+	}
+	for _, d := range docs {
+		if d.Ref.ID != id {
+			return fmt.Errorf("%w: %s", ErrTeamNameTaken, key)
+		}
+	}
+	return nil
+}
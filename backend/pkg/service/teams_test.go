@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTeamName(t *testing.T) {
+	assert.Equal(t, "web班", NormalizeTeamName("Web班"))
+	assert.Equal(t, "web班", NormalizeTeamName("ＷＥＢ班"))
+	assert.Equal(t, "ai 研究会", NormalizeTeamName("  AI　 研究会 "))
+	// 半角カナも全角にそろえる
+	assert.Equal(t, NormalizeTeamName("ゲーム班"), NormalizeTeamName("ｹﾞｰﾑ班"))
+}
+
+func TestResolveTeams(t *testing.T) {
+	teams := []Team{
+		{Id: "web", Name: "Web班", Kind: TeamKindTeam},
+		{Id: "leader", Name: "代表", Kind: TeamKindOfficer},
+	}
+
+	got, err := ResolveTeams(teams, []string{"leader", "web班", "Web班"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Team{teams[1], teams[0]}, got)
+
+	_, err = ResolveTeams(teams, []string{"Web班", "会計"})
+	assert.ErrorIs(t, err, ErrUnknownTeam)
+
+	var m Member
+	m.SetTeams(got)
+	assert.Equal(t, []string{"leader", "web"}, m.RoleIDs)
+	assert.Equal(t, []string{"代表", "Web班"}, m.Roles)
+}
+
+func TestRenamedRoles(t *testing.T) {
+	// 名前が同じでも別の ID の役職は書き換えない
+	m := Member{RoleIDs: []string{"leader", "web", "leader-2"}, Roles: []string{"代表", "Web班", "代表"}}
+	assert.Equal(t, []string{"代表", "Webチーム", "代表"}, m.renamedRoles("web", "Webチーム"))
+	assert.Equal(t, []string{"部長", "Web班", "代表"}, m.renamedRoles("leader", "部長"))
+	assert.Equal(t, []string{"代表", "Web班", "代表"}, m.Roles)
+}
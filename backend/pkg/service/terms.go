@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	api "github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/metrics"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleTermsCollection = "role_terms"
+
+// ErrTermNotFound は指定した任期の記録が存在しない（またはそのメンバーのものでない）ことを表す。
+var ErrTermNotFound = errors.New("role term not found")
+
+// RoleTerm は役職の任期の記録。"role_terms" コレクションに 1 件 1 ドキュメントで保存する。
+// メンバーの現在の役職は Member.Roles が正で、こちらは歴代就任者などの履歴に使う。
+type RoleTerm struct {
+	Id       string `firestore:"-"`
+	MemberID string `firestore:"member_id"`
+	// MemberName は記録したときの名前。メンバーを削除しても歴代の一覧に残すため持っておく
+	MemberName string    `firestore:"member_name"`
+	RoleID     string    `firestore:"role_id"`
+	Role       string    `firestore:"role"`
+	StartDate  time.Time `firestore:"start_date"`
+	// EndDate は任期の最終日（その日を含む）。nil は任期中
+	EndDate   *time.Time `firestore:"end_date,omitempty"`
+	CreatedBy string     `firestore:"created_by"`
+	CreatedAt time.Time  `firestore:"created_at"`
+}
+
+// CurrentAt は now 時点で任期中かを返す。
+func (t *RoleTerm) CurrentAt(now time.Time) bool {
+	if now.Before(t.StartDate) {
+		return false
+	}
+	return t.EndDate == nil || now.Before(t.EndDate.AddDate(0, 0, 1))
+}
+
+// ToAPI は RoleTerm を API レスポンス用に変換する。
+func (t *RoleTerm) ToAPI(now time.Time) api.RoleTerm {
+	out := api.RoleTerm{
+		Id:           t.Id,
+		MemberId:     t.MemberID,
+		MemberName:   t.MemberName,
+		RoleId:       t.RoleID,
+		Role:         t.Role,
+		AcademicYear: AcademicYear(t.StartDate),
+		StartDate:    openapi_types.Date{Time: t.StartDate.In(jst)},
+		Current:      t.CurrentAt(now),
+	}
+	if t.EndDate != nil {
+		out.EndDate = &openapi_types.Date{Time: t.EndDate.In(jst)}
+	}
+	return out
+}
+
+// SortTerms は任期の開始が新しい順に並べる。
+func SortTerms(terms []RoleTerm) {
+	slices.SortStableFunc(terms, func(a, b RoleTerm) int { return b.StartDate.Compare(a.StartDate) })
+}
+
+// TermsService は Firestore の "role_terms" コレクションに対する操作を提供する。
+type TermsService struct {
+	fs *firestore.Client
+}
+
+// NewTermsService は TermsService を生成する。
+func NewTermsService(fs *firestore.Client) *TermsService {
+	return &TermsService{fs: fs}
+}
+
+// ListByMember はメンバーの任期を新しい順に返す。
+func (s *TermsService) ListByMember(ctx context.Context, memberID string) ([]RoleTerm, error) {
+	ctx, span := tracer.Start(ctx, "TermsService.ListByMember", trace.WithAttributes(attribute.String("member.id", memberID)))
+	defer span.End()
+
+	terms, err := s.query(ctx, s.fs.Collection(roleTermsCollection).Where("member_id", "==", memberID))
+	return terms, endSpanIfErr(span, err)
+}
+
+// ListByRole は役職の歴代の任期を新しい順に返す。
+func (s *TermsService) ListByRole(ctx context.Context, roleID string) ([]RoleTerm, error) {
+	ctx, span := tracer.Start(ctx, "TermsService.ListByRole", trace.WithAttributes(attribute.String("team.id", roleID)))
+	defer span.End()
+
+	terms, err := s.query(ctx, s.fs.Collection(roleTermsCollection).Where("role_id", "==", roleID))
+	return terms, endSpanIfErr(span, err)
+}
+
+func (s *TermsService) query(ctx context.Context, q firestore.Query) ([]RoleTerm, error) {
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		metrics.FirestoreError(roleTermsCollection, "read")
+		return nil, err
+	}
+	metrics.FirestoreRead(roleTermsCollection, len(docs))
+
+	out := make([]RoleTerm, 0, len(docs))
+	for _, doc := range docs {
+		var t RoleTerm
+		if err := doc.DataTo(&t); err != nil {
+			return nil, err
+		}
+		t.Id = doc.Ref.ID
+		out = append(out, t)
+	}
+	SortTerms(out)
+	return out, nil
+}
+
+// Create は任期を記録する。
+func (s *TermsService) Create(ctx context.Context, t RoleTerm) (*RoleTerm, error) {
+	ctx, span := tracer.Start(ctx, "TermsService.Create", trace.WithAttributes(
+		attribute.String("member.id", t.MemberID),
+		attribute.String("team.id", t.RoleID),
+	))
+	defer span.End()
+
+	t.CreatedAt = time.Now()
+	ref, _, err := s.fs.Collection(roleTermsCollection).Add(ctx, t)
+	if err != nil {
+		metrics.FirestoreError(roleTermsCollection, "write")
+		return nil, endSpan(span, err)
+	}
+	metrics.FirestoreWrite(roleTermsCollection, 1)
+	t.Id = ref.ID
+	return &t, nil
+}
+
+// Delete はメンバーの任期の記録を削除する。別のメンバーの記録なら ErrTermNotFound。
+func (s *TermsService) Delete(ctx context.Context, memberID, termID string) error {
+	ctx, span := tracer.Start(ctx, "TermsService.Delete", trace.WithAttributes(attribute.String("member.id", memberID)))
+	defer span.End()
+
+	ref := s.fs.Collection(roleTermsCollection).Doc(termID)
+	return endSpanIfErr(span, s.fs.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return ErrTermNotFound
+		}
+		if err != nil {
+			metrics.FirestoreError(roleTermsCollection, "read")
+			return err
+		}
+		if id, _ := doc.DataAt("member_id"); id != memberID {
+			return ErrTermNotFound
+		}
+		if err := tx.Delete(ref); err != nil {
+			metrics.FirestoreError(roleTermsCollection, "write")
+			return err
+		}
+		metrics.FirestoreWrite(roleTermsCollection, 1)
+		return nil
+	}))
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleTermCurrentAt(t *testing.T) {
+	first, last := AcademicYearSpan(2024)
+	term := RoleTerm{StartDate: first, EndDate: &last}
+
+	assert.False(t, term.CurrentAt(first.Add(-time.Second)))
+	assert.True(t, term.CurrentAt(first))
+	// 最終日（3/31）は終日任期中
+	assert.True(t, term.CurrentAt(time.Date(2025, 3, 31, 23, 59, 0, 0, jst)))
+	assert.False(t, term.CurrentAt(time.Date(2025, 4, 1, 0, 0, 0, 0, jst)))
+
+	term.EndDate = nil
+	assert.True(t, term.CurrentAt(time.Date(2030, 1, 1, 0, 0, 0, 0, jst)))
+	assert.Equal(t, 2024, term.ToAPI(first).AcademicYear)
+}
@@ -0,0 +1,68 @@
+package vcard
+
+import (
+	"strings"
+
+	"github.com/Lumos-Programming/profile-system-backend/api"
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+)
+
+// hiddenName は名前を公開していないメンバーにニックネームもないときの表示名
+const hiddenName = "（名前非公開）"
+
+// FromMember はメンバーの連絡先を作る。self（本人が取得している）でなければ Visibility に従い、
+// 名前を公開していないメンバーは名前の代わりにニックネームを使い、SNS は公開しているものだけを含める。
+// リンクと GitHub 連携はメンバー詳細と同じものを、Discord 連携はプロフィールに載せる設定のときだけ含める。
+// メールアドレスと学籍番号は含めない。
+func FromMember(m *service.Member, self bool) Card {
+	c := Card{Nickname: m.Nickname, Categories: m.Roles}
+	if m.Avatar != nil && isWebURL(*m.Avatar) {
+		c.Photo = *m.Avatar
+	}
+
+	if (self || m.Visibility.Name) && strings.TrimSpace(m.Name) != "" {
+		c.FormattedName = m.Name
+		// 「田中 太郎」は姓・名に分ける（全角空白でもよい）
+		if fields := strings.Fields(m.Name); len(fields) > 1 {
+			c.FamilyName, c.GivenName = fields[0], strings.Join(fields[1:], " ")
+		} else {
+			c.FamilyName = m.Name
+		}
+	} else {
+		c.FormattedName = m.Nickname
+		c.Nickname = ""
+		if c.FormattedName == "" {
+			c.FormattedName = hiddenName
+		}
+	}
+	if c.Nickname == c.FormattedName {
+		c.Nickname = ""
+	}
+
+	seen := map[string]bool{}
+	addURL := func(u string) {
+		key := strings.ToLower(strings.TrimRight(u, "/"))
+		if isWebURL(u) && !seen[key] {
+			seen[key] = true
+			c.URLs = append(c.URLs, u)
+		}
+	}
+	for _, l := range m.ToDetail().Links {
+		addURL(l.Url)
+	}
+	social := m.SocialLinks(self)
+	for _, a := range []*api.SocialAccount{social.X, social.Instagram, social.Github, social.Website} {
+		if a != nil {
+			addURL(a.Url)
+		}
+	}
+	if m.Discord != nil && m.Discord.Username != "" && (self || m.Discord.ShowOnProfile) {
+		c.Social = append(c.Social, SocialProfile{Service: "Discord", Username: m.Discord.Username})
+	}
+	return c
+}
+
+func isWebURL(u string) bool {
+	_, ok := webURL(u)
+	return ok
+}
@@ -0,0 +1,138 @@
+// Package vcard はメンバーの連絡先を vCard 4.0（RFC 6350）で書き出す。
+package vcard
+
+import (
+	"bufio"
+	"io"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContentType は vCard の Content-Type。
+const ContentType = "text/vcard; charset=utf-8"
+
+// maxLineOctets は折り返す前の 1 行の長さ（RFC 6350 3.2。CRLF を除く）
+const maxLineOctets = 75
+
+// SocialProfile は URL のないアカウント（Discord のユーザー名など）。RFC 9554 の SOCIALPROFILE で書く。
+type SocialProfile struct {
+	Service  string
+	Username string
+}
+
+// Card は 1 人分の連絡先。空のフィールドは書き出さない。
+type Card struct {
+	// FormattedName は表示名（FN）。必須
+	FormattedName string
+	// FamilyName・GivenName は構造化した名前（N）。どちらも空なら N を書かない
+	FamilyName string
+	GivenName  string
+	Nickname   string
+	// Photo はアイコン画像の URL。Photo と URLs は http(s) の URL だけを書き出す（webURL を参照）
+	Photo      string
+	Categories []string
+	URLs       []string
+	Social     []SocialProfile
+	Note       string
+}
+
+// Write は cards を続けて w に書き出す（1 枚でも複数でも同じ形式）。
+func Write(w io.Writer, cards ...Card) error {
+	bw := bufio.NewWriter(w)
+	for _, c := range cards {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+func (c *Card) write(w *bufio.Writer) {
+	line := func(s string) {
+		writeFolded(w, s)
+	}
+	line("BEGIN:VCARD")
+	line("VERSION:4.0")
+	line("KIND:individual")
+	line("FN:" + escapeText(c.FormattedName))
+	if c.FamilyName != "" || c.GivenName != "" {
+		line("N:" + escapeText(c.FamilyName) + ";" + escapeText(c.GivenName) + ";;;")
+	}
+	if c.Nickname != "" {
+		line("NICKNAME:" + escapeText(c.Nickname))
+	}
+	if u, ok := webURL(c.Photo); ok {
+		line("PHOTO:" + escapeText(u))
+	}
+	if len(c.Categories) > 0 {
+		escaped := make([]string, len(c.Categories))
+		for i, cat := range c.Categories {
+			escaped[i] = escapeText(cat)
+		}
+		line("CATEGORIES:" + strings.Join(escaped, ","))
+	}
+	for _, u := range c.URLs {
+		if u, ok := webURL(u); ok {
+			line("URL:" + escapeText(u))
+		}
+	}
+	for _, p := range c.Social {
+		line("SOCIALPROFILE;SERVICE-TYPE=" + quoteParam(p.Service) + ";VALUE=text:" + escapeText(p.Username))
+	}
+	if c.Note != "" {
+		line("NOTE:" + escapeText(c.Note))
+	}
+	line("END:VCARD")
+}
+
+// escapeText は TEXT 値の \ , ; と改行をエスケープする（RFC 6350 3.4）。
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		",", `\,`,
+		";", `\;`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// webURL は s が http(s) の URL として読めれば正規化した文字列を返す。
+// 制御文字（改行など）を含むものはプロパティを書き換えられてしまうので受け付けない。
+func webURL(s string) (string, bool) {
+	if s == "" || strings.ContainsFunc(s, unicode.IsControl) {
+		return "", false
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", false
+	}
+	return u.String(), true
+}
+
+// quoteParam はパラメータ値に : ; , が含まれていれば二重引用符で囲む（値の中の " は使えないので除く）。
+func quoteParam(s string) string {
+	s = strings.ReplaceAll(s, `"`, "")
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// writeFolded は 1 行を 75 オクテットごとに折り返して CRLF 付きで書く。
+// 続きの行は空白 1 つで始める。UTF-8 の文字の途中では折り返さない。
+func writeFolded(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1 // 先頭の空白の分
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
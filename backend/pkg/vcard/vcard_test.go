@@ -0,0 +1,126 @@
+package vcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lumos-Programming/profile-system-backend/pkg/service"
+)
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, Card{
+		FormattedName: "田中 太郎",
+		FamilyName:    "田中",
+		GivenName:     "太郎",
+		Nickname:      "たな;たろ",
+		Categories:    []string{"Web班", "代表,副"},
+		URLs:          []string{"https://github.com/tanaka"},
+		Social:        []SocialProfile{{Service: "Discord", Username: "tanataro"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "BEGIN:VCARD\r\n" +
+		"VERSION:4.0\r\n" +
+		"KIND:individual\r\n" +
+		"FN:田中 太郎\r\n" +
+		"N:田中;太郎;;;\r\n" +
+		"NICKNAME:たな\\;たろ\r\n" +
+		"CATEGORIES:Web班,代表\\,副\r\n" +
+		"URL:https://github.com/tanaka\r\n" +
+		"SOCIALPROFILE;SERVICE-TYPE=Discord;VALUE=text:tanataro\r\n" +
+		"END:VCARD\r\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected vcard:\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestWrite_RejectsInjectedURLs(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, Card{
+		FormattedName: "x",
+		Photo:         "https://example.com/a.png\r\nEMAIL:evil@example.com",
+		URLs: []string{
+			"https://example.com/\r\nTEL:000",
+			"javascript:alert(1)",
+			"https://example.com/a,b;c",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	if strings.Contains(got, "EMAIL") || strings.Contains(got, "TEL") || strings.Contains(got, "PHOTO") || strings.Contains(got, "javascript") {
+		t.Fatalf("injected URLs should not be written: %q", got)
+	}
+	if !strings.Contains(got, "URL:https://example.com/a\\,b\\;c\r\n") {
+		t.Fatalf("URL should be escaped: %q", got)
+	}
+}
+
+func TestWrite_FoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, Card{FormattedName: "x", Note: strings.Repeat("あ", 60)}); err != nil {
+		t.Fatal(err)
+	}
+	var unfolded strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Fatalf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+			continue
+		}
+		unfolded.WriteString("\n" + line)
+	}
+	if !strings.Contains(unfolded.String(), "\nNOTE:"+strings.Repeat("あ", 60)+"\n") {
+		t.Fatalf("folded lines should join back without breaking characters: %q", unfolded.String())
+	}
+}
+
+func TestFromMember(t *testing.T) {
+	avatar := "https://example.com/a.png"
+	m := service.Member{
+		Id:       "m1",
+		Name:     "田中　太郎",
+		Nickname: "たなたろ",
+		Avatar:   &avatar,
+		Roles:    []string{"Web班"},
+		Social:   service.SocialHandles{X: "tanataro", Instagram: "tana"},
+		GitHub:   &service.LinkedAccount{Username: "tanaka", ProfileURL: "https://github.com/tanaka", ShowOnProfile: true, LinkedAt: time.Now()},
+		Discord:  &service.LinkedAccount{Username: "tanataro#1"},
+	}
+	m.Visibility.X = true
+	m.Links = append(m.Links, struct {
+		Title string `firestore:"title"`
+		Url   string `firestore:"url"`
+	}{Title: "GitHub", Url: "https://GitHub.com/tanaka/"}, struct {
+		Title string `firestore:"title"`
+		Url   string `firestore:"url"`
+	}{Title: "bad", Url: "javascript:alert(1)"})
+
+	// 名前を公開していないメンバーは、本人以外にはニックネームで出す
+	c := FromMember(&m, false)
+	if c.FormattedName != "たなたろ" || c.FamilyName != "" || c.Nickname != "" {
+		t.Fatalf("name should be hidden: %+v", c)
+	}
+	if len(c.URLs) != 2 || c.URLs[0] != "https://GitHub.com/tanaka/" || c.URLs[1] != "https://x.com/tanataro" {
+		t.Fatalf("unexpected urls: %v", c.URLs)
+	}
+	if len(c.Social) != 0 || c.Photo != avatar {
+		t.Fatalf("unexpected card: %+v", c)
+	}
+
+	// 本人には全部
+	c = FromMember(&m, true)
+	if c.FormattedName != "田中　太郎" || c.FamilyName != "田中" || c.GivenName != "太郎" || c.Nickname != "たなたろ" {
+		t.Fatalf("unexpected name: %+v", c)
+	}
+	if len(c.URLs) != 3 || len(c.Social) != 1 {
+		t.Fatalf("the member should see every account: %+v", c)
+	}
+}
@@ -22,9 +22,21 @@ import { DUMMY_BASE_URL, assertParamExists, setApiKeyToObject, setBasicAuthToObj
 // @ts-ignore
 import { BASE_PATH, COLLECTION_FORMATS, type RequestArgs, BaseAPI, RequiredError, operationServerMap } from '../base';
 // @ts-ignore
+import type { AccountLink } from '../models';
+// @ts-ignore
+import type { AuthResult } from '../models';
+// @ts-ignore
 import type { BasicInfo } from '../models';
 // @ts-ignore
-import type { LineOAuthResponse } from '../models';
+import type { DuplicateCandidate } from '../models';
+// @ts-ignore
+import type { ImportReport } from '../models';
+// @ts-ignore
+import type { Invitation } from '../models';
+// @ts-ignore
+import type { InvitationCreate } from '../models';
+// @ts-ignore
+import type { InvitationStatus } from '../models';
 // @ts-ignore
 import type { MemberCreate } from '../models';
 // @ts-ignore
@@ -32,8 +44,34 @@ import type { MemberCreateResponse } from '../models';
 // @ts-ignore
 import type { MemberDetail } from '../models';
 // @ts-ignore
+import type { MemberMerge } from '../models';
+// @ts-ignore
+import type { MemberSearchHit } from '../models';
+// @ts-ignore
+import type { MemberStatus } from '../models';
+// @ts-ignore
+import type { MemberStatusUpdate } from '../models';
+// @ts-ignore
 import type { MemberSummary } from '../models';
 // @ts-ignore
+import type { RoleTerm } from '../models';
+// @ts-ignore
+import type { RoleTermCreate } from '../models';
+// @ts-ignore
+import type { SimilarMember } from '../models';
+// @ts-ignore
+import type { SocialProfile } from '../models';
+// @ts-ignore
+import type { TagCount } from '../models';
+// @ts-ignore
+import type { TagsUpdate } from '../models';
+// @ts-ignore
+import type { Team } from '../models';
+// @ts-ignore
+import type { TeamInput } from '../models';
+// @ts-ignore
+import type { TeamKind } from '../models';
+// @ts-ignore
 import type { UpdateResponse } from '../models';
 /**
  * DefaultApi - axios parameter creator
@@ -42,17 +80,14 @@ import type { UpdateResponse } from '../models';
 export const DefaultApiAxiosParamCreator = function (configuration?: Configuration) {
     return {
         /**
-         * LINEのOAuthコールバックURLとして、付与されたcodeをアクセストークンに交換し、認証済みユーザー情報を返します。
-         * @summary LINE OAuthコールバック
-         * @param {string} code LINE OAuth認可コード
-         * @param {string} [state] CSRF対策のstate値
+         * 発行済みの招待コードを新しい順に返します。statusで未使用・使用済み・期限切れに絞り込めます。
+         * @summary 招待コードの一覧を取得する（役員のみ）
+         * @param {InvitationStatus} [status] 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiLineOauthGet: async (code: string, state?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'code' is not null or undefined
-            assertParamExists('apiLineOauthGet', 'code', code)
-            const localVarPath = `/api/line-oauth`;
+        apiAdminInvitationsGet: async (status?: InvitationStatus, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/admin/invitations`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -64,12 +99,10 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
-            if (code !== undefined) {
-                localVarQueryParameter['code'] = code;
-            }
+            // authentication cookieAuth required
 
-            if (state !== undefined) {
-                localVarQueryParameter['state'] = state;
+            if (status !== undefined) {
+                localVarQueryParameter['status'] = status;
             }
 
 
@@ -84,13 +117,16 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * サークルメンバーのサマリ情報一覧を返します。
-         * @summary メンバー一覧を取得する
+         * 使用回数の上限と有効期限付きの招待コードを発行します。役職・学年を指定すると、登録時にその値が使われます。
+         * @summary 招待コードを発行する（役員のみ）
+         * @param {InvitationCreate} invitationCreate 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiMembersGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/api/members`;
+        apiAdminInvitationsPost: async (invitationCreate: InvitationCreate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'invitationCreate' is not null or undefined
+            assertParamExists('apiAdminInvitationsPost', 'invitationCreate', invitationCreate)
+            const localVarPath = `/api/admin/invitations`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -98,15 +134,20 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            // authentication cookieAuth required
+
 
     
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(invitationCreate, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -114,17 +155,13 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 指定したメンバーの詳細情報を返します。
-         * @summary メンバー詳細を取得する
-         * @param {string} id 
+         * 削除済みのメンバーを、削除が新しい順に返します。 削除済みのメンバーは保持期間（deleted_member_retention）を過ぎると完全に削除され、復元できなくなります。 
+         * @summary 削除済みのメンバーを取得する（役員のみ）
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiMembersIdGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'id' is not null or undefined
-            assertParamExists('apiMembersIdGet', 'id', id)
-            const localVarPath = `/api/members/{id}`
-                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+        apiAdminMembersDeletedGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/admin/members/deleted`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -136,6 +173,8 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            // authentication cookieAuth required
+
 
     
             setSearchParams(localVarUrlObj, localVarQueryParameter);
@@ -148,16 +187,14 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 新しいメンバーを登録します。
-         * @summary メンバーを登録する
-         * @param {MemberCreate} memberCreate 
+         * 同じ人が二重に登録されていそうなメンバーの組を、疑わしい順に返します。 学籍番号・LINEユーザーID・メールアドレスの一致と、名前の近さ（全角・半角、ひらがな・カタカナ、空白の違いを無視し、ローマ字でも比べる）から判定します。 
+         * @summary 重複していそうなメンバーを取得する（役員のみ）
+         * @param {number} [minScore] この値以上の組だけを返す
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiMembersPost: async (memberCreate: MemberCreate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'memberCreate' is not null or undefined
-            assertParamExists('apiMembersPost', 'memberCreate', memberCreate)
-            const localVarPath = `/api/members`;
+        apiAdminMembersDuplicatesGet: async (minScore?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/admin/members/duplicates`;
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -165,18 +202,21 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            // authentication cookieAuth required
+
+            if (minScore !== undefined) {
+                localVarQueryParameter['min_score'] = minScore;
+            }
 
-    
-            localVarHeaderParameter['Content-Type'] = 'application/json';
 
+    
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(memberCreate, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -184,13 +224,17 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 現在登録されているユーザーの基本情報を返します。
-         * @summary 基本情報を取得する
+         * 承認待ち（pending）のメンバーを在籍中（active）にします。
+         * @summary 承認待ちのメンバーを承認する（役員のみ）
+         * @param {string} id 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiProfileBasicInfoGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/api/profile/basic-info`;
+        apiAdminMembersIdApprovePost: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdApprovePost', 'id', id)
+            const localVarPath = `/api/admin/members/{id}/approve`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -198,10 +242,12 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            // authentication cookieAuth required
+
 
     
             setSearchParams(localVarUrlObj, localVarQueryParameter);
@@ -214,16 +260,17 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 学籍番号や名前、自己紹介などの基本情報を編集します。
-         * @summary 基本情報を更新する
-         * @param {BasicInfo} basicInfo 
+         * メンバーを削除済みにします。一覧・検索・詳細に出なくなり、本人もログインできなくなります。 イベントの参加履歴や役職の任期は残るので、保持期間のうちは restore で元に戻せます。 
+         * @summary メンバーを削除する（役員のみ）
+         * @param {string} id 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiProfileBasicInfoPut: async (basicInfo: BasicInfo, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'basicInfo' is not null or undefined
-            assertParamExists('apiProfileBasicInfoPut', 'basicInfo', basicInfo)
-            const localVarPath = `/api/profile/basic-info`;
+        apiAdminMembersIdDelete: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdDelete', 'id', id)
+            const localVarPath = `/api/admin/members/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -231,143 +278,2489 @@ export const DefaultApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
+            // authentication cookieAuth required
 
-    
-            localVarHeaderParameter['Content-Type'] = 'application/json';
 
+    
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(basicInfo, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
                 options: localVarRequestOptions,
             };
         },
-    }
-};
-
-/**
- * DefaultApi - functional programming interface
- * @export
- */
-export const DefaultApiFp = function(configuration?: Configuration) {
-    const localVarAxiosParamCreator = DefaultApiAxiosParamCreator(configuration)
-    return {
         /**
-         * LINEのOAuthコールバックURLとして、付与されたcodeをアクセストークンに交換し、認証済みユーザー情報を返します。
-         * @summary LINE OAuthコールバック
-         * @param {string} code LINE OAuth認可コード
-         * @param {string} [state] CSRF対策のstate値
+         * source_id のメンバーを {id} のメンバーに統合します。{id} 側の値を優先し、空の項目だけを source_id 側から補います。 役職・タグ・リンク・イベントの参加履歴はまとめ、ログインID・役職の任期・招待コードの利用記録も {id} に移します。 削除済みのメンバーは統合できません（復元してから統合してください）。 source_id のメンバーは一覧から消え、詳細を取得すると {id} へリダイレクトされます。 
+         * @summary 重複したメンバーを統合する（役員のみ）
+         * @param {string} id 
+         * @param {MemberMerge} memberMerge 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiLineOauthGet(code: string, state?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<LineOAuthResponse>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiLineOauthGet(code, state, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiLineOauthGet']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        apiAdminMembersIdMergePost: async (id: string, memberMerge: MemberMerge, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdMergePost', 'id', id)
+            // verify required parameter 'memberMerge' is not null or undefined
+            assertParamExists('apiAdminMembersIdMergePost', 'memberMerge', memberMerge)
+            const localVarPath = `/api/admin/members/{id}/merge`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(memberMerge, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
         },
         /**
-         * サークルメンバーのサマリ情報一覧を返します。
-         * @summary メンバー一覧を取得する
+         * 承認待ち（pending）のメンバーを、ログインIDの紐づけごと削除します。本人は招待コードで登録し直せます。
+         * @summary 承認待ちのメンバーを却下する（役員のみ）
+         * @param {string} id 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiMembersGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberSummary>>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersGet(options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersGet']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        apiAdminMembersIdRejectPost: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdRejectPost', 'id', id)
+            const localVarPath = `/api/admin/members/{id}/reject`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
         },
         /**
-         * 指定したメンバーの詳細情報を返します。
-         * @summary メンバー詳細を取得する
+         * 
+         * @summary 削除したメンバーを元に戻す（役員のみ）
          * @param {string} id 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiMembersIdGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberDetail>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdGet(id, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdGet']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        apiAdminMembersIdRestorePost: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdRestorePost', 'id', id)
+            const localVarPath = `/api/admin/members/{id}/restore`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
         },
         /**
-         * 新しいメンバーを登録します。
-         * @summary メンバーを登録する
-         * @param {MemberCreate} memberCreate 
+         * 在籍中・卒業生・利用停止を切り替えます。承認待ちへの変更や承認待ちからの変更は approve / reject を使ってください。
+         * @summary メンバーのステータスを変更する（役員のみ）
+         * @param {string} id 
+         * @param {MemberStatusUpdate} memberStatusUpdate 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiMembersPost(memberCreate: MemberCreate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberCreateResponse>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersPost(memberCreate, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersPost']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        apiAdminMembersIdStatusPut: async (id: string, memberStatusUpdate: MemberStatusUpdate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiAdminMembersIdStatusPut', 'id', id)
+            // verify required parameter 'memberStatusUpdate' is not null or undefined
+            assertParamExists('apiAdminMembersIdStatusPut', 'memberStatusUpdate', memberStatusUpdate)
+            const localVarPath = `/api/admin/members/{id}/status`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(memberStatusUpdate, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
         },
         /**
-         * 現在登録されているユーザーの基本情報を返します。
-         * @summary 基本情報を取得する
+         * 本人が登録して承認待ち（pending）のメンバーを、登録が古い順に返します。
+         * @summary 承認待ちのメンバーを取得する（役員のみ）
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiProfileBasicInfoGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BasicInfo>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiProfileBasicInfoGet(options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiProfileBasicInfoGet']?.[localVarOperationServerIndex]?.url;
-            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        apiAdminMembersPendingGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/admin/members/pending`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
         },
         /**
-         * 学籍番号や名前、自己紹介などの基本情報を編集します。
-         * @summary 基本情報を更新する
-         * @param {BasicInfo} basicInfo 
+         * codeをトークンに交換してプロバイダのユーザー（subject）を取得し、member_identities で対応するメンバーを探します。 メンバーが見つかればセッションCookie（auth_token）を発行します。 見つからず、すでにログイン中であれば、そのメンバーにこのプロバイダのIDを追加で紐づけます。 
+         * @summary 外部IDプロバイダのコールバック
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {string} code 認可コード
+         * @param {string} [state] CSRF対策のstate値
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async apiProfileBasicInfoPut(basicInfo: BasicInfo, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UpdateResponse>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.apiProfileBasicInfoPut(basicInfo, options);
-            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+        apiAuthProviderCallbackGet: async (provider: string, code: string, state?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'provider' is not null or undefined
+            assertParamExists('apiAuthProviderCallbackGet', 'provider', provider)
+            // verify required parameter 'code' is not null or undefined
+            assertParamExists('apiAuthProviderCallbackGet', 'code', code)
+            const localVarPath = `/api/auth/{provider}/callback`
+                .replace(`{${"provider"}}`, encodeURIComponent(String(provider)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (code !== undefined) {
+                localVarQueryParameter['code'] = code;
+            }
+
+            if (state !== undefined) {
+                localVarQueryParameter['state'] = state;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * stateとnonceを発行してCookieに保存し、指定したプロバイダ（line、または設定ファイルの identity_providers に書いたもの）の認可画面へリダイレクトします。
+         * @summary 外部IDプロバイダでのログインを開始する
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAuthProviderStartGet: async (provider: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'provider' is not null or undefined
+            assertParamExists('apiAuthProviderStartGet', 'provider', provider)
+            const localVarPath = `/api/auth/{provider}/start`
+                .replace(`{${"provider"}}`, encodeURIComponent(String(provider)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * /api/auth/line/start と同じ処理です。stateとnonceを発行してCookieに保存し、openid/profile/emailスコープを付けたLINEの認可画面へリダイレクトします。新しいクライアントは /api/auth/line/start を使ってください。
+         * @summary LINEログインを開始する
+         * @param {*} [options] Override http request option.
+         * @deprecated
+         * @throws {RequiredError}
+         */
+        apiLineLoginGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/line-login`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * /api/auth/line/callback と同じ処理です。付与されたcodeをトークンに交換し、IDトークン（署名・iss・aud・nonce・exp）を検証したうえで、member_identities で対応するメンバーとしてログインさせます。メンバーがいれば、検証済みのメールアドレスを保存します。新しいクライアントは /api/auth/line/callback を使ってください。
+         * @summary LINE OAuthコールバック
+         * @param {string} code LINE OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @deprecated
+         * @throws {RequiredError}
+         */
+        apiLineOauthGet: async (code: string, state?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'code' is not null or undefined
+            assertParamExists('apiLineOauthGet', 'code', code)
+            const localVarPath = `/api/line-oauth`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (code !== undefined) {
+                localVarQueryParameter['code'] = code;
+            }
+
+            if (state !== undefined) {
+                localVarQueryParameter['state'] = state;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 自分のメンバー情報とログインIDの紐づけを削除し、ログイン中のセッションをすべて無効にします。元には戻せません。 役職の任期は団体の歴代記録として残しますが、名前は「退会したメンバー」に置き換えます。 
+         * @summary 退会して自分のデータを削除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeDelete: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 保存している自分のデータをJSONファイルにしてZIPでまとめて返します。   - profile.json: 他のメンバーに見えるプロフィール（自分が見たときのもの）   - member.json: メンバー情報として保存しているすべての項目（メールアドレス・学籍番号などを含む）   - identities.json: ログインに使う外部IDの紐づけ   - invitations.json: 自分が発行した招待コードと、自分が使って登録した招待コード（他のメンバーの利用記録は含みません）   - audit.json: 自分についての記録（役職の任期の登録、招待コードの発行・利用、ログインIDの紐づけ） 
+         * @summary 自分のデータをまとめてダウンロードする
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeExportGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/export`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してDiscordユーザーを取得し、ログイン中のメンバーに紐づけます。accounts.discordは自動的にtrueになります。
+         * @summary Discordアカウント連携のコールバック
+         * @param {string} code Discord OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordCallbackGet: async (code: string, state?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'code' is not null or undefined
+            assertParamExists('apiMeLinksDiscordCallbackGet', 'code', code)
+            const localVarPath = `/api/me/links/discord/callback`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+            if (code !== undefined) {
+                localVarQueryParameter['code'] = code;
+            }
+
+            if (state !== undefined) {
+                localVarQueryParameter['state'] = state;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ログイン中のメンバーからDiscordアカウントの紐づけを外し、accounts.discordをfalseにします。
+         * @summary Discordアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordDelete: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/links/discord`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、Discordの認可画面へリダイレクトします。
+         * @summary Discordアカウント連携を開始する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordStartGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/links/discord/start`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してGitHubユーザーを取得し、ログイン中のメンバーにloginとIDを紐づけます。accounts.githubは自動的にtrueになります。
+         * @summary GitHubアカウント連携のコールバック
+         * @param {string} code GitHub OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubCallbackGet: async (code: string, state?: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'code' is not null or undefined
+            assertParamExists('apiMeLinksGithubCallbackGet', 'code', code)
+            const localVarPath = `/api/me/links/github/callback`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+            if (code !== undefined) {
+                localVarQueryParameter['code'] = code;
+            }
+
+            if (state !== undefined) {
+                localVarQueryParameter['state'] = state;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ログイン中のメンバーからGitHubアカウントの紐づけを外し、accounts.githubをfalseにします。linksに自動で載せていたプロフィールURLも消えます。
+         * @summary GitHubアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubDelete: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/links/github`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、GitHubの認可画面へリダイレクトします。
+         * @summary GitHubアカウント連携を開始する
+         * @param {boolean} [showOnProfile] 連携後、GitHubのプロフィールURLをメンバー詳細のlinksに自動で載せるか
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubStartGet: async (showOnProfile?: boolean, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/links/github/start`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+            if (showOnProfile !== undefined) {
+                localVarQueryParameter['show_on_profile'] = showOnProfile;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary 自分のSNSアカウント設定を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeSocialGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/me/social`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * X・Instagram・GitHubのユーザー名と個人サイトのURLを登録します。 「@tanataro」や「https://x.com/tanataro」のようなURLでも受け付け、ユーザー名にそろえて保存します。 他のメンバーには visibility が true のものだけが表示されます。 
+         * @summary 自分のSNSアカウント設定を更新する
+         * @param {SocialProfile} socialProfile 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeSocialPut: async (socialProfile: SocialProfile, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'socialProfile' is not null or undefined
+            assertParamExists('apiMeSocialPut', 'socialProfile', socialProfile)
+            const localVarPath = `/api/me/social`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(socialProfile, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * タグを丸ごと置き換えます。表記は正規化して保存します（「ＰＹＴＨＯＮ」「py」は「python」）。
+         * @summary 自分のスキル・興味タグを更新する
+         * @param {TagsUpdate} tagsUpdate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeTagsPut: async (tagsUpdate: TagsUpdate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'tagsUpdate' is not null or undefined
+            assertParamExists('apiMeTagsPut', 'tagsUpdate', tagsUpdate)
+            const localVarPath = `/api/me/tags`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(tagsUpdate, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 大学への提出や共有用に、メンバー名簿をCSV・Excel（xlsx）・JSONで書き出します。 columns で列と並び順を選べます（省略時は学籍番号・メールアドレス以外の列）。CSVはExcelで開けるようBOM付きのUTF-8で、 roles 列の形式は一括登録（/api/members/import）と同じです。  学籍番号（student_id）とメールアドレス（email）は個人情報のため、include_sensitive=true を明示したときだけ書き出せます。 
+         * @summary メンバー名簿を書き出す（役員のみ）
+         * @param {ApiMembersExportGetFormatEnum} [format] 
+         * @param {Array<ApiMembersExportGetColumnsEnum>} [columns] 書き出す列（カンマ区切り、指定した順に並ぶ）
+         * @param {boolean} [includeSensitive] true のときだけ student_id・email の列を書き出せる
+         * @param {MemberStatus} [status] 在籍状況で絞り込む（省略時は全員）
+         * @param {string} [role] 班・役職（名前かID）で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+         * @param {number} [enrollmentYear] 入学年度で絞り込む
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersExportGet: async (format?: ApiMembersExportGetFormatEnum, columns?: Array<ApiMembersExportGetColumnsEnum>, includeSensitive?: boolean, status?: MemberStatus, role?: string, tag?: Array<string>, enrollmentYear?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/members/export`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+            if (format !== undefined) {
+                localVarQueryParameter['format'] = format;
+            }
+
+            if (columns) {
+                localVarQueryParameter['columns'] = columns.join(COLLECTION_FORMATS.csv);
+            }
+
+            if (includeSensitive !== undefined) {
+                localVarQueryParameter['include_sensitive'] = includeSensitive;
+            }
+
+            if (status !== undefined) {
+                localVarQueryParameter['status'] = status;
+            }
+
+            if (role !== undefined) {
+                localVarQueryParameter['role'] = role;
+            }
+
+            if (tag) {
+                localVarQueryParameter['tag'] = tag;
+            }
+
+            if (enrollmentYear !== undefined) {
+                localVarQueryParameter['enrollment_year'] = enrollmentYear;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * サークルメンバーのサマリ情報一覧を返します。 status で在籍中（active）と卒業生（alumni）を切り替えられます。省略時は在籍中のみ（役員は全員）です。 承認待ち・利用停止のメンバーは役員にだけ返します。 
+         * @summary メンバー一覧を取得する
+         * @param {MemberStatus} [status] 在籍状況で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersGet: async (status?: MemberStatus, tag?: Array<string>, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/members`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (status !== undefined) {
+                localVarQueryParameter['status'] = status;
+            }
+
+            if (tag) {
+                localVarQueryParameter['tag'] = tag;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 指定したメンバーの詳細情報を返します。承認待ち・利用停止のメンバーは役員と本人以外に、削除済みのメンバーは役員以外に見つからない扱い（404）になります。
+         * @summary メンバー詳細を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiMembersIdGet', 'id', id)
+            const localVarPath = `/api/members/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * スキル・興味タグの重なりが大きい順に他のメンバーを返します。新入生がメンターを探すのに使います。 多くの人が持っているタグより、持っている人の少ないタグの一致を重く見ます。タグが 1 つも重ならないメンバーは返しません。 
+         * @summary 興味の近いメンバーを取得する
+         * @param {string} id 
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdSimilarGet: async (id: string, limit?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiMembersIdSimilarGet', 'id', id)
+            const localVarPath = `/api/members/{id}/similar`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (limit !== undefined) {
+                localVarQueryParameter['limit'] = limit;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 役職の任期を記録します。academic_year を指定するとその年度（4月1日〜翌3月31日）を任期とし、 年度の途中で交代した場合は start_date / end_date で指定します。 
+         * @summary メンバーの任期を記録する（役員のみ）
+         * @param {string} id 
+         * @param {RoleTermCreate} roleTermCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdTermsPost: async (id: string, roleTermCreate: RoleTermCreate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiMembersIdTermsPost', 'id', id)
+            // verify required parameter 'roleTermCreate' is not null or undefined
+            assertParamExists('apiMembersIdTermsPost', 'roleTermCreate', roleTermCreate)
+            const localVarPath = `/api/members/{id}/terms`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(roleTermCreate, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary 任期の記録を削除する（役員のみ）
+         * @param {string} id 
+         * @param {string} termId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdTermsTermIdDelete: async (id: string, termId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiMembersIdTermsTermIdDelete', 'id', id)
+            // verify required parameter 'termId' is not null or undefined
+            assertParamExists('apiMembersIdTermsTermIdDelete', 'termId', termId)
+            const localVarPath = `/api/members/{id}/terms/{termId}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)))
+                .replace(`{${"termId"}}`, encodeURIComponent(String(termId)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * スマートフォンの連絡先に登録できる vCard（4.0）を返します。名前・ニックネーム・アイコン・役職と、 リンク・連携アカウント・SNSアカウントのURLを含めます。 本人以外には visibility の設定に従い、name が false なら名前の代わりにニックネームを、SNSは公開しているものだけを含めます。 メールアドレスと学籍番号は含めません。 
+         * @summary メンバーの連絡先（vCard）を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdVcardGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiMembersIdVcardGet', 'id', id)
+            const localVarPath = `/api/members/{id}/vcard`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 新入生などをCSVでまとめて登録します。1行目は見出し行で、列の順番は自由です。 列は name（名前）、nickname（ニックネーム）、student_id（学籍番号）、department（学部・学科）、year（学年または入学年度）、roles（班・役職。複数は「;」区切り）で、 日本語の見出し（名前・ニックネーム・学籍番号・学部・学年・役職）も使えます。文字コードはUTF-8（BOM付きも可）です。  dry_run が true（既定）のときは登録せずに行ごとの確認結果だけを返します。結果を確認してから dry_run=false で送ると、 エラーのない行だけを在籍中のメンバーとして登録します。学籍番号が登録済みのメンバーやCSV内の別の行と重なる行は登録しません。 
+         * @summary CSVからメンバーをまとめて登録する（役員のみ）
+         * @param {string} body 
+         * @param {boolean} [dryRun] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersImportPost: async (body: string, dryRun?: boolean, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'body' is not null or undefined
+            assertParamExists('apiMembersImportPost', 'body', body)
+            const localVarPath = `/api/members/import`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+            if (dryRun !== undefined) {
+                localVarQueryParameter['dry_run'] = dryRun;
+            }
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'text/csv';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(body, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 新しいメンバーを登録します。 役員は招待コードなしで登録できます。それ以外は、外部IDでログインして未登録と判定されたあと（onboarding_token Cookie）に、有効な招待コードを添えて自分を登録します。 自分を登録した場合はそのままログイン状態になります。 
+         * @summary メンバーを登録する
+         * @param {MemberCreate} memberCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersPost: async (memberCreate: MemberCreate, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'memberCreate' is not null or undefined
+            assertParamExists('apiMembersPost', 'memberCreate', memberCreate)
+            const localVarPath = `/api/members`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(memberCreate, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 名前・ニックネーム・役職・自己紹介からメンバーを検索し、一致度の高い順に返します。 全角・半角、ひらがな・カタカナの違いは無視し、かなの名前やニックネームはローマ字でも検索できます。 空白で区切ると、すべての語に一致するメンバーだけを返します。役員以外には承認待ち・利用停止のメンバーは返しません。 
+         * @summary メンバーを検索する
+         * @param {string} q 検索語
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersSearchGet: async (q: string, limit?: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'q' is not null or undefined
+            assertParamExists('apiMembersSearchGet', 'q', q)
+            const localVarPath = `/api/members/search`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (q !== undefined) {
+                localVarQueryParameter['q'] = q;
+            }
+
+            if (limit !== undefined) {
+                localVarQueryParameter['limit'] = limit;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 現在登録されているユーザーの基本情報を返します。
+         * @summary 基本情報を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiProfileBasicInfoGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/profile/basic-info`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 学籍番号や名前、自己紹介などの基本情報を編集します。SNSアカウントは /api/me/social で更新します。
+         * @summary 基本情報を更新する
+         * @param {BasicInfo} basicInfo 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiProfileBasicInfoPut: async (basicInfo: BasicInfo, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'basicInfo' is not null or undefined
+            assertParamExists('apiProfileBasicInfoPut', 'basicInfo', basicInfo)
+            const localVarPath = `/api/profile/basic-info`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(basicInfo, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * メンバーに付いているタグと、そのタグを持つメンバー数を多い順に返します。役員以外には承認待ち・利用停止のメンバーを数えません。
+         * @summary スキル・興味タグの一覧を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTagsGet: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/tags`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 登録されている班と役職を表示順に返します。kind で班（team）か役職（officer）に絞り込めます。
+         * @summary 班・役職の一覧を取得する
+         * @param {TeamKind} [kind] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsGet: async (kind?: TeamKind, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/teams`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            if (kind !== undefined) {
+                localVarQueryParameter['kind'] = kind;
+            }
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 所属しているメンバーがいる間は削除できません。
+         * @summary 班・役職を削除する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdDelete: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdDelete', 'id', id)
+            const localVarPath = `/api/teams/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary 班・役職を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdGet', 'id', id)
+            const localVarPath = `/api/teams/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 指定した班・役職の任期を新しい順に返します。サークルの歴史ページ用です。退会したメンバーも当時の名前で残ります。
+         * @summary 役職の歴代就任者を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdHoldersGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdHoldersGet', 'id', id)
+            const localVarPath = `/api/teams/{id}/holders`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 役員以外には在籍中（active）のメンバーだけを返します。
+         * @summary 班・役職に所属するメンバーを取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdMembersGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdMembersGet', 'id', id)
+            const localVarPath = `/api/teams/{id}/members`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 名前を変えると、所属メンバーの表示上の役職名も合わせて更新します。 役員の判定に使う役職（設定の auth.officer_roles にある名前）は、その名前からも、その名前へも変えられません。 
+         * @summary 班・役職を更新する（役員のみ）
+         * @param {string} id 
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdPut: async (id: string, teamInput: TeamInput, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdPut', 'id', id)
+            // verify required parameter 'teamInput' is not null or undefined
+            assertParamExists('apiTeamsIdPut', 'teamInput', teamInput)
+            const localVarPath = `/api/teams/{id}`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(teamInput, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 班・役職に所属するメンバーの vCard（4.0）を1つの .vcf ファイルにまとめて返します。 対象は /api/teams/{id}/members と同じで、各メンバーの中身は /api/members/{id}/vcard と同じ規則で作ります。 
+         * @summary 班・役職のメンバーの連絡先をまとめて取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdVcardGet: async (id: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('apiTeamsIdVcardGet', 'id', id)
+            const localVarPath = `/api/teams/{id}/vcard`
+                .replace(`{${"id"}}`, encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+
+    
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 名前は全角・半角や大文字・小文字の違いを無視して重複を確認します。
+         * @summary 班・役職を追加する（役員のみ）
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsPost: async (teamInput: TeamInput, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'teamInput' is not null or undefined
+            assertParamExists('apiTeamsPost', 'teamInput', teamInput)
+            const localVarPath = `/api/teams`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication cookieAuth required
+
+
+    
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(teamInput, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * DefaultApi - functional programming interface
+ * @export
+ */
+export const DefaultApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = DefaultApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * 発行済みの招待コードを新しい順に返します。statusで未使用・使用済み・期限切れに絞り込めます。
+         * @summary 招待コードの一覧を取得する（役員のみ）
+         * @param {InvitationStatus} [status] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminInvitationsGet(status?: InvitationStatus, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Invitation>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminInvitationsGet(status, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminInvitationsGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 使用回数の上限と有効期限付きの招待コードを発行します。役職・学年を指定すると、登録時にその値が使われます。
+         * @summary 招待コードを発行する（役員のみ）
+         * @param {InvitationCreate} invitationCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminInvitationsPost(invitationCreate: InvitationCreate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Invitation>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminInvitationsPost(invitationCreate, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminInvitationsPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 削除済みのメンバーを、削除が新しい順に返します。 削除済みのメンバーは保持期間（deleted_member_retention）を過ぎると完全に削除され、復元できなくなります。 
+         * @summary 削除済みのメンバーを取得する（役員のみ）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersDeletedGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberDetail>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersDeletedGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersDeletedGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 同じ人が二重に登録されていそうなメンバーの組を、疑わしい順に返します。 学籍番号・LINEユーザーID・メールアドレスの一致と、名前の近さ（全角・半角、ひらがな・カタカナ、空白の違いを無視し、ローマ字でも比べる）から判定します。 
+         * @summary 重複していそうなメンバーを取得する（役員のみ）
+         * @param {number} [minScore] この値以上の組だけを返す
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersDuplicatesGet(minScore?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<DuplicateCandidate>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersDuplicatesGet(minScore, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersDuplicatesGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 承認待ち（pending）のメンバーを在籍中（active）にします。
+         * @summary 承認待ちのメンバーを承認する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdApprovePost(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdApprovePost(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdApprovePost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * メンバーを削除済みにします。一覧・検索・詳細に出なくなり、本人もログインできなくなります。 イベントの参加履歴や役職の任期は残るので、保持期間のうちは restore で元に戻せます。 
+         * @summary メンバーを削除する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdDelete(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdDelete(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * source_id のメンバーを {id} のメンバーに統合します。{id} 側の値を優先し、空の項目だけを source_id 側から補います。 役職・タグ・リンク・イベントの参加履歴はまとめ、ログインID・役職の任期・招待コードの利用記録も {id} に移します。 削除済みのメンバーは統合できません（復元してから統合してください）。 source_id のメンバーは一覧から消え、詳細を取得すると {id} へリダイレクトされます。 
+         * @summary 重複したメンバーを統合する（役員のみ）
+         * @param {string} id 
+         * @param {MemberMerge} memberMerge 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdMergePost(id: string, memberMerge: MemberMerge, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberDetail>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdMergePost(id, memberMerge, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdMergePost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 承認待ち（pending）のメンバーを、ログインIDの紐づけごと削除します。本人は招待コードで登録し直せます。
+         * @summary 承認待ちのメンバーを却下する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdRejectPost(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdRejectPost(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdRejectPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary 削除したメンバーを元に戻す（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdRestorePost(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberDetail>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdRestorePost(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdRestorePost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 在籍中・卒業生・利用停止を切り替えます。承認待ちへの変更や承認待ちからの変更は approve / reject を使ってください。
+         * @summary メンバーのステータスを変更する（役員のみ）
+         * @param {string} id 
+         * @param {MemberStatusUpdate} memberStatusUpdate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersIdStatusPut(id: string, memberStatusUpdate: MemberStatusUpdate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersIdStatusPut(id, memberStatusUpdate, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersIdStatusPut']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 本人が登録して承認待ち（pending）のメンバーを、登録が古い順に返します。
+         * @summary 承認待ちのメンバーを取得する（役員のみ）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAdminMembersPendingGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberDetail>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAdminMembersPendingGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAdminMembersPendingGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * codeをトークンに交換してプロバイダのユーザー（subject）を取得し、member_identities で対応するメンバーを探します。 メンバーが見つかればセッションCookie（auth_token）を発行します。 見つからず、すでにログイン中であれば、そのメンバーにこのプロバイダのIDを追加で紐づけます。 
+         * @summary 外部IDプロバイダのコールバック
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {string} code 認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAuthProviderCallbackGet(provider: string, code: string, state?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<AuthResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAuthProviderCallbackGet(provider, code, state, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAuthProviderCallbackGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * stateとnonceを発行してCookieに保存し、指定したプロバイダ（line、または設定ファイルの identity_providers に書いたもの）の認可画面へリダイレクトします。
+         * @summary 外部IDプロバイダでのログインを開始する
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiAuthProviderStartGet(provider: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiAuthProviderStartGet(provider, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiAuthProviderStartGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * /api/auth/line/start と同じ処理です。stateとnonceを発行してCookieに保存し、openid/profile/emailスコープを付けたLINEの認可画面へリダイレクトします。新しいクライアントは /api/auth/line/start を使ってください。
+         * @summary LINEログインを開始する
+         * @param {*} [options] Override http request option.
+         * @deprecated
+         * @throws {RequiredError}
+         */
+        async apiLineLoginGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiLineLoginGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiLineLoginGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * /api/auth/line/callback と同じ処理です。付与されたcodeをトークンに交換し、IDトークン（署名・iss・aud・nonce・exp）を検証したうえで、member_identities で対応するメンバーとしてログインさせます。メンバーがいれば、検証済みのメールアドレスを保存します。新しいクライアントは /api/auth/line/callback を使ってください。
+         * @summary LINE OAuthコールバック
+         * @param {string} code LINE OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @deprecated
+         * @throws {RequiredError}
+         */
+        async apiLineOauthGet(code: string, state?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<AuthResult>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiLineOauthGet(code, state, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiLineOauthGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 自分のメンバー情報とログインIDの紐づけを削除し、ログイン中のセッションをすべて無効にします。元には戻せません。 役職の任期は団体の歴代記録として残しますが、名前は「退会したメンバー」に置き換えます。 
+         * @summary 退会して自分のデータを削除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeDelete(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeDelete(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 保存している自分のデータをJSONファイルにしてZIPでまとめて返します。   - profile.json: 他のメンバーに見えるプロフィール（自分が見たときのもの）   - member.json: メンバー情報として保存しているすべての項目（メールアドレス・学籍番号などを含む）   - identities.json: ログインに使う外部IDの紐づけ   - invitations.json: 自分が発行した招待コードと、自分が使って登録した招待コード（他のメンバーの利用記録は含みません）   - audit.json: 自分についての記録（役職の任期の登録、招待コードの発行・利用、ログインIDの紐づけ） 
+         * @summary 自分のデータをまとめてダウンロードする
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeExportGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<File>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeExportGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeExportGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してDiscordユーザーを取得し、ログイン中のメンバーに紐づけます。accounts.discordは自動的にtrueになります。
+         * @summary Discordアカウント連携のコールバック
+         * @param {string} code Discord OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksDiscordCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<AccountLink>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksDiscordCallbackGet(code, state, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksDiscordCallbackGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ログイン中のメンバーからDiscordアカウントの紐づけを外し、accounts.discordをfalseにします。
+         * @summary Discordアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksDiscordDelete(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksDiscordDelete(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksDiscordDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、Discordの認可画面へリダイレクトします。
+         * @summary Discordアカウント連携を開始する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksDiscordStartGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksDiscordStartGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksDiscordStartGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してGitHubユーザーを取得し、ログイン中のメンバーにloginとIDを紐づけます。accounts.githubは自動的にtrueになります。
+         * @summary GitHubアカウント連携のコールバック
+         * @param {string} code GitHub OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksGithubCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<AccountLink>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksGithubCallbackGet(code, state, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksGithubCallbackGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ログイン中のメンバーからGitHubアカウントの紐づけを外し、accounts.githubをfalseにします。linksに自動で載せていたプロフィールURLも消えます。
+         * @summary GitHubアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksGithubDelete(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksGithubDelete(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksGithubDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、GitHubの認可画面へリダイレクトします。
+         * @summary GitHubアカウント連携を開始する
+         * @param {boolean} [showOnProfile] 連携後、GitHubのプロフィールURLをメンバー詳細のlinksに自動で載せるか
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeLinksGithubStartGet(showOnProfile?: boolean, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeLinksGithubStartGet(showOnProfile, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeLinksGithubStartGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary 自分のSNSアカウント設定を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeSocialGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<SocialProfile>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeSocialGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeSocialGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * X・Instagram・GitHubのユーザー名と個人サイトのURLを登録します。 「@tanataro」や「https://x.com/tanataro」のようなURLでも受け付け、ユーザー名にそろえて保存します。 他のメンバーには visibility が true のものだけが表示されます。 
+         * @summary 自分のSNSアカウント設定を更新する
+         * @param {SocialProfile} socialProfile 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeSocialPut(socialProfile: SocialProfile, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<SocialProfile>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeSocialPut(socialProfile, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeSocialPut']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * タグを丸ごと置き換えます。表記は正規化して保存します（「ＰＹＴＨＯＮ」「py」は「python」）。
+         * @summary 自分のスキル・興味タグを更新する
+         * @param {TagsUpdate} tagsUpdate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMeTagsPut(tagsUpdate: TagsUpdate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<TagsUpdate>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMeTagsPut(tagsUpdate, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMeTagsPut']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 大学への提出や共有用に、メンバー名簿をCSV・Excel（xlsx）・JSONで書き出します。 columns で列と並び順を選べます（省略時は学籍番号・メールアドレス以外の列）。CSVはExcelで開けるようBOM付きのUTF-8で、 roles 列の形式は一括登録（/api/members/import）と同じです。  学籍番号（student_id）とメールアドレス（email）は個人情報のため、include_sensitive=true を明示したときだけ書き出せます。 
+         * @summary メンバー名簿を書き出す（役員のみ）
+         * @param {ApiMembersExportGetFormatEnum} [format] 
+         * @param {Array<ApiMembersExportGetColumnsEnum>} [columns] 書き出す列（カンマ区切り、指定した順に並ぶ）
+         * @param {boolean} [includeSensitive] true のときだけ student_id・email の列を書き出せる
+         * @param {MemberStatus} [status] 在籍状況で絞り込む（省略時は全員）
+         * @param {string} [role] 班・役職（名前かID）で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+         * @param {number} [enrollmentYear] 入学年度で絞り込む
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersExportGet(format?: ApiMembersExportGetFormatEnum, columns?: Array<ApiMembersExportGetColumnsEnum>, includeSensitive?: boolean, status?: MemberStatus, role?: string, tag?: Array<string>, enrollmentYear?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersExportGet(format, columns, includeSensitive, status, role, tag, enrollmentYear, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersExportGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * サークルメンバーのサマリ情報一覧を返します。 status で在籍中（active）と卒業生（alumni）を切り替えられます。省略時は在籍中のみ（役員は全員）です。 承認待ち・利用停止のメンバーは役員にだけ返します。 
+         * @summary メンバー一覧を取得する
+         * @param {MemberStatus} [status] 在籍状況で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersGet(status?: MemberStatus, tag?: Array<string>, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberSummary>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersGet(status, tag, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 指定したメンバーの詳細情報を返します。承認待ち・利用停止のメンバーは役員と本人以外に、削除済みのメンバーは役員以外に見つからない扱い（404）になります。
+         * @summary メンバー詳細を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersIdGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberDetail>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * スキル・興味タグの重なりが大きい順に他のメンバーを返します。新入生がメンターを探すのに使います。 多くの人が持っているタグより、持っている人の少ないタグの一致を重く見ます。タグが 1 つも重ならないメンバーは返しません。 
+         * @summary 興味の近いメンバーを取得する
+         * @param {string} id 
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersIdSimilarGet(id: string, limit?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<SimilarMember>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdSimilarGet(id, limit, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdSimilarGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 役職の任期を記録します。academic_year を指定するとその年度（4月1日〜翌3月31日）を任期とし、 年度の途中で交代した場合は start_date / end_date で指定します。 
+         * @summary メンバーの任期を記録する（役員のみ）
+         * @param {string} id 
+         * @param {RoleTermCreate} roleTermCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersIdTermsPost(id: string, roleTermCreate: RoleTermCreate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<RoleTerm>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdTermsPost(id, roleTermCreate, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdTermsPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary 任期の記録を削除する（役員のみ）
+         * @param {string} id 
+         * @param {string} termId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersIdTermsTermIdDelete(id: string, termId: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdTermsTermIdDelete(id, termId, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdTermsTermIdDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * スマートフォンの連絡先に登録できる vCard（4.0）を返します。名前・ニックネーム・アイコン・役職と、 リンク・連携アカウント・SNSアカウントのURLを含めます。 本人以外には visibility の設定に従い、name が false なら名前の代わりにニックネームを、SNSは公開しているものだけを含めます。 メールアドレスと学籍番号は含めません。 
+         * @summary メンバーの連絡先（vCard）を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersIdVcardGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersIdVcardGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersIdVcardGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 新入生などをCSVでまとめて登録します。1行目は見出し行で、列の順番は自由です。 列は name（名前）、nickname（ニックネーム）、student_id（学籍番号）、department（学部・学科）、year（学年または入学年度）、roles（班・役職。複数は「;」区切り）で、 日本語の見出し（名前・ニックネーム・学籍番号・学部・学年・役職）も使えます。文字コードはUTF-8（BOM付きも可）です。  dry_run が true（既定）のときは登録せずに行ごとの確認結果だけを返します。結果を確認してから dry_run=false で送ると、 エラーのない行だけを在籍中のメンバーとして登録します。学籍番号が登録済みのメンバーやCSV内の別の行と重なる行は登録しません。 
+         * @summary CSVからメンバーをまとめて登録する（役員のみ）
+         * @param {string} body 
+         * @param {boolean} [dryRun] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersImportPost(body: string, dryRun?: boolean, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<ImportReport>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersImportPost(body, dryRun, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersImportPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 新しいメンバーを登録します。 役員は招待コードなしで登録できます。それ以外は、外部IDでログインして未登録と判定されたあと（onboarding_token Cookie）に、有効な招待コードを添えて自分を登録します。 自分を登録した場合はそのままログイン状態になります。 
+         * @summary メンバーを登録する
+         * @param {MemberCreate} memberCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersPost(memberCreate: MemberCreate, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<MemberCreateResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersPost(memberCreate, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 名前・ニックネーム・役職・自己紹介からメンバーを検索し、一致度の高い順に返します。 全角・半角、ひらがな・カタカナの違いは無視し、かなの名前やニックネームはローマ字でも検索できます。 空白で区切ると、すべての語に一致するメンバーだけを返します。役員以外には承認待ち・利用停止のメンバーは返しません。 
+         * @summary メンバーを検索する
+         * @param {string} q 検索語
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiMembersSearchGet(q: string, limit?: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberSearchHit>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiMembersSearchGet(q, limit, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiMembersSearchGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 現在登録されているユーザーの基本情報を返します。
+         * @summary 基本情報を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiProfileBasicInfoGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<BasicInfo>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiProfileBasicInfoGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiProfileBasicInfoGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 学籍番号や名前、自己紹介などの基本情報を編集します。SNSアカウントは /api/me/social で更新します。
+         * @summary 基本情報を更新する
+         * @param {BasicInfo} basicInfo 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiProfileBasicInfoPut(basicInfo: BasicInfo, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<UpdateResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiProfileBasicInfoPut(basicInfo, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
             const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiProfileBasicInfoPut']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
-    }
-};
-
-/**
- * DefaultApi - factory interface
- * @export
- */
-export const DefaultApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
-    const localVarFp = DefaultApiFp(configuration)
-    return {
         /**
-         * LINEのOAuthコールバックURLとして、付与されたcodeをアクセストークンに交換し、認証済みユーザー情報を返します。
+         * メンバーに付いているタグと、そのタグを持つメンバー数を多い順に返します。役員以外には承認待ち・利用停止のメンバーを数えません。
+         * @summary スキル・興味タグの一覧を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTagsGet(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<TagCount>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTagsGet(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTagsGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 登録されている班と役職を表示順に返します。kind で班（team）か役職（officer）に絞り込めます。
+         * @summary 班・役職の一覧を取得する
+         * @param {TeamKind} [kind] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsGet(kind?: TeamKind, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<Team>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsGet(kind, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 所属しているメンバーがいる間は削除できません。
+         * @summary 班・役職を削除する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdDelete(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdDelete(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 
+         * @summary 班・役職を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Team>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 指定した班・役職の任期を新しい順に返します。サークルの歴史ページ用です。退会したメンバーも当時の名前で残ります。
+         * @summary 役職の歴代就任者を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdHoldersGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<RoleTerm>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdHoldersGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdHoldersGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 役員以外には在籍中（active）のメンバーだけを返します。
+         * @summary 班・役職に所属するメンバーを取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdMembersGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Array<MemberSummary>>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdMembersGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdMembersGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 名前を変えると、所属メンバーの表示上の役職名も合わせて更新します。 役員の判定に使う役職（設定の auth.officer_roles にある名前）は、その名前からも、その名前へも変えられません。 
+         * @summary 班・役職を更新する（役員のみ）
+         * @param {string} id 
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdPut(id: string, teamInput: TeamInput, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Team>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdPut(id, teamInput, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdPut']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 班・役職に所属するメンバーの vCard（4.0）を1つの .vcf ファイルにまとめて返します。 対象は /api/teams/{id}/members と同じで、各メンバーの中身は /api/members/{id}/vcard と同じ規則で作ります。 
+         * @summary 班・役職のメンバーの連絡先をまとめて取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsIdVcardGet(id: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<string>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsIdVcardGet(id, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsIdVcardGet']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 名前は全角・半角や大文字・小文字の違いを無視して重複を確認します。
+         * @summary 班・役職を追加する（役員のみ）
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async apiTeamsPost(teamInput: TeamInput, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Team>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.apiTeamsPost(teamInput, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DefaultApi.apiTeamsPost']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * DefaultApi - factory interface
+ * @export
+ */
+export const DefaultApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = DefaultApiFp(configuration)
+    return {
+        /**
+         * 発行済みの招待コードを新しい順に返します。statusで未使用・使用済み・期限切れに絞り込めます。
+         * @summary 招待コードの一覧を取得する（役員のみ）
+         * @param {InvitationStatus} [status] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminInvitationsGet(status?: InvitationStatus, options?: RawAxiosRequestConfig): AxiosPromise<Array<Invitation>> {
+            return localVarFp.apiAdminInvitationsGet(status, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 使用回数の上限と有効期限付きの招待コードを発行します。役職・学年を指定すると、登録時にその値が使われます。
+         * @summary 招待コードを発行する（役員のみ）
+         * @param {InvitationCreate} invitationCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminInvitationsPost(invitationCreate: InvitationCreate, options?: RawAxiosRequestConfig): AxiosPromise<Invitation> {
+            return localVarFp.apiAdminInvitationsPost(invitationCreate, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 削除済みのメンバーを、削除が新しい順に返します。 削除済みのメンバーは保持期間（deleted_member_retention）を過ぎると完全に削除され、復元できなくなります。 
+         * @summary 削除済みのメンバーを取得する（役員のみ）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersDeletedGet(options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberDetail>> {
+            return localVarFp.apiAdminMembersDeletedGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 同じ人が二重に登録されていそうなメンバーの組を、疑わしい順に返します。 学籍番号・LINEユーザーID・メールアドレスの一致と、名前の近さ（全角・半角、ひらがな・カタカナ、空白の違いを無視し、ローマ字でも比べる）から判定します。 
+         * @summary 重複していそうなメンバーを取得する（役員のみ）
+         * @param {number} [minScore] この値以上の組だけを返す
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersDuplicatesGet(minScore?: number, options?: RawAxiosRequestConfig): AxiosPromise<Array<DuplicateCandidate>> {
+            return localVarFp.apiAdminMembersDuplicatesGet(minScore, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 承認待ち（pending）のメンバーを在籍中（active）にします。
+         * @summary 承認待ちのメンバーを承認する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdApprovePost(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiAdminMembersIdApprovePost(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * メンバーを削除済みにします。一覧・検索・詳細に出なくなり、本人もログインできなくなります。 イベントの参加履歴や役職の任期は残るので、保持期間のうちは restore で元に戻せます。 
+         * @summary メンバーを削除する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdDelete(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiAdminMembersIdDelete(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * source_id のメンバーを {id} のメンバーに統合します。{id} 側の値を優先し、空の項目だけを source_id 側から補います。 役職・タグ・リンク・イベントの参加履歴はまとめ、ログインID・役職の任期・招待コードの利用記録も {id} に移します。 削除済みのメンバーは統合できません（復元してから統合してください）。 source_id のメンバーは一覧から消え、詳細を取得すると {id} へリダイレクトされます。 
+         * @summary 重複したメンバーを統合する（役員のみ）
+         * @param {string} id 
+         * @param {MemberMerge} memberMerge 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdMergePost(id: string, memberMerge: MemberMerge, options?: RawAxiosRequestConfig): AxiosPromise<MemberDetail> {
+            return localVarFp.apiAdminMembersIdMergePost(id, memberMerge, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 承認待ち（pending）のメンバーを、ログインIDの紐づけごと削除します。本人は招待コードで登録し直せます。
+         * @summary 承認待ちのメンバーを却下する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdRejectPost(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiAdminMembersIdRejectPost(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary 削除したメンバーを元に戻す（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdRestorePost(id: string, options?: RawAxiosRequestConfig): AxiosPromise<MemberDetail> {
+            return localVarFp.apiAdminMembersIdRestorePost(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 在籍中・卒業生・利用停止を切り替えます。承認待ちへの変更や承認待ちからの変更は approve / reject を使ってください。
+         * @summary メンバーのステータスを変更する（役員のみ）
+         * @param {string} id 
+         * @param {MemberStatusUpdate} memberStatusUpdate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersIdStatusPut(id: string, memberStatusUpdate: MemberStatusUpdate, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiAdminMembersIdStatusPut(id, memberStatusUpdate, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 本人が登録して承認待ち（pending）のメンバーを、登録が古い順に返します。
+         * @summary 承認待ちのメンバーを取得する（役員のみ）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAdminMembersPendingGet(options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberDetail>> {
+            return localVarFp.apiAdminMembersPendingGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * codeをトークンに交換してプロバイダのユーザー（subject）を取得し、member_identities で対応するメンバーを探します。 メンバーが見つかればセッションCookie（auth_token）を発行します。 見つからず、すでにログイン中であれば、そのメンバーにこのプロバイダのIDを追加で紐づけます。 
+         * @summary 外部IDプロバイダのコールバック
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {string} code 認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAuthProviderCallbackGet(provider: string, code: string, state?: string, options?: RawAxiosRequestConfig): AxiosPromise<AuthResult> {
+            return localVarFp.apiAuthProviderCallbackGet(provider, code, state, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * stateとnonceを発行してCookieに保存し、指定したプロバイダ（line、または設定ファイルの identity_providers に書いたもの）の認可画面へリダイレクトします。
+         * @summary 外部IDプロバイダでのログインを開始する
+         * @param {string} provider プロバイダ名（例 line / google）
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiAuthProviderStartGet(provider: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiAuthProviderStartGet(provider, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * /api/auth/line/start と同じ処理です。stateとnonceを発行してCookieに保存し、openid/profile/emailスコープを付けたLINEの認可画面へリダイレクトします。新しいクライアントは /api/auth/line/start を使ってください。
+         * @summary LINEログインを開始する
+         * @param {*} [options] Override http request option.
+         * @deprecated
+         * @throws {RequiredError}
+         */
+        apiLineLoginGet(options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiLineLoginGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * /api/auth/line/callback と同じ処理です。付与されたcodeをトークンに交換し、IDトークン（署名・iss・aud・nonce・exp）を検証したうえで、member_identities で対応するメンバーとしてログインさせます。メンバーがいれば、検証済みのメールアドレスを保存します。新しいクライアントは /api/auth/line/callback を使ってください。
          * @summary LINE OAuthコールバック
          * @param {string} code LINE OAuth認可コード
          * @param {string} [state] CSRF対策のstate値
          * @param {*} [options] Override http request option.
+         * @deprecated
          * @throws {RequiredError}
          */
-        apiLineOauthGet(code: string, state?: string, options?: RawAxiosRequestConfig): AxiosPromise<LineOAuthResponse> {
+        apiLineOauthGet(code: string, state?: string, options?: RawAxiosRequestConfig): AxiosPromise<AuthResult> {
             return localVarFp.apiLineOauthGet(code, state, options).then((request) => request(axios, basePath));
         },
         /**
-         * サークルメンバーのサマリ情報一覧を返します。
+         * 自分のメンバー情報とログインIDの紐づけを削除し、ログイン中のセッションをすべて無効にします。元には戻せません。 役職の任期は団体の歴代記録として残しますが、名前は「退会したメンバー」に置き換えます。 
+         * @summary 退会して自分のデータを削除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeDelete(options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMeDelete(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 保存している自分のデータをJSONファイルにしてZIPでまとめて返します。   - profile.json: 他のメンバーに見えるプロフィール（自分が見たときのもの）   - member.json: メンバー情報として保存しているすべての項目（メールアドレス・学籍番号などを含む）   - identities.json: ログインに使う外部IDの紐づけ   - invitations.json: 自分が発行した招待コードと、自分が使って登録した招待コード（他のメンバーの利用記録は含みません）   - audit.json: 自分についての記録（役職の任期の登録、招待コードの発行・利用、ログインIDの紐づけ） 
+         * @summary 自分のデータをまとめてダウンロードする
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeExportGet(options?: RawAxiosRequestConfig): AxiosPromise<File> {
+            return localVarFp.apiMeExportGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してDiscordユーザーを取得し、ログイン中のメンバーに紐づけます。accounts.discordは自動的にtrueになります。
+         * @summary Discordアカウント連携のコールバック
+         * @param {string} code Discord OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig): AxiosPromise<AccountLink> {
+            return localVarFp.apiMeLinksDiscordCallbackGet(code, state, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ログイン中のメンバーからDiscordアカウントの紐づけを外し、accounts.discordをfalseにします。
+         * @summary Discordアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordDelete(options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMeLinksDiscordDelete(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、Discordの認可画面へリダイレクトします。
+         * @summary Discordアカウント連携を開始する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksDiscordStartGet(options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMeLinksDiscordStartGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 付与されたcodeをアクセストークンに交換してGitHubユーザーを取得し、ログイン中のメンバーにloginとIDを紐づけます。accounts.githubは自動的にtrueになります。
+         * @summary GitHubアカウント連携のコールバック
+         * @param {string} code GitHub OAuth認可コード
+         * @param {string} [state] CSRF対策のstate値
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig): AxiosPromise<AccountLink> {
+            return localVarFp.apiMeLinksGithubCallbackGet(code, state, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ログイン中のメンバーからGitHubアカウントの紐づけを外し、accounts.githubをfalseにします。linksに自動で載せていたプロフィールURLも消えます。
+         * @summary GitHubアカウント連携を解除する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubDelete(options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMeLinksGithubDelete(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ログイン中のメンバーについて、stateを発行してCookieに保存し、GitHubの認可画面へリダイレクトします。
+         * @summary GitHubアカウント連携を開始する
+         * @param {boolean} [showOnProfile] 連携後、GitHubのプロフィールURLをメンバー詳細のlinksに自動で載せるか
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeLinksGithubStartGet(showOnProfile?: boolean, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMeLinksGithubStartGet(showOnProfile, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary 自分のSNSアカウント設定を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeSocialGet(options?: RawAxiosRequestConfig): AxiosPromise<SocialProfile> {
+            return localVarFp.apiMeSocialGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * X・Instagram・GitHubのユーザー名と個人サイトのURLを登録します。 「@tanataro」や「https://x.com/tanataro」のようなURLでも受け付け、ユーザー名にそろえて保存します。 他のメンバーには visibility が true のものだけが表示されます。 
+         * @summary 自分のSNSアカウント設定を更新する
+         * @param {SocialProfile} socialProfile 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeSocialPut(socialProfile: SocialProfile, options?: RawAxiosRequestConfig): AxiosPromise<SocialProfile> {
+            return localVarFp.apiMeSocialPut(socialProfile, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * タグを丸ごと置き換えます。表記は正規化して保存します（「ＰＹＴＨＯＮ」「py」は「python」）。
+         * @summary 自分のスキル・興味タグを更新する
+         * @param {TagsUpdate} tagsUpdate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMeTagsPut(tagsUpdate: TagsUpdate, options?: RawAxiosRequestConfig): AxiosPromise<TagsUpdate> {
+            return localVarFp.apiMeTagsPut(tagsUpdate, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 大学への提出や共有用に、メンバー名簿をCSV・Excel（xlsx）・JSONで書き出します。 columns で列と並び順を選べます（省略時は学籍番号・メールアドレス以外の列）。CSVはExcelで開けるようBOM付きのUTF-8で、 roles 列の形式は一括登録（/api/members/import）と同じです。  学籍番号（student_id）とメールアドレス（email）は個人情報のため、include_sensitive=true を明示したときだけ書き出せます。 
+         * @summary メンバー名簿を書き出す（役員のみ）
+         * @param {ApiMembersExportGetFormatEnum} [format] 
+         * @param {Array<ApiMembersExportGetColumnsEnum>} [columns] 書き出す列（カンマ区切り、指定した順に並ぶ）
+         * @param {boolean} [includeSensitive] true のときだけ student_id・email の列を書き出せる
+         * @param {MemberStatus} [status] 在籍状況で絞り込む（省略時は全員）
+         * @param {string} [role] 班・役職（名前かID）で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+         * @param {number} [enrollmentYear] 入学年度で絞り込む
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersExportGet(format?: ApiMembersExportGetFormatEnum, columns?: Array<ApiMembersExportGetColumnsEnum>, includeSensitive?: boolean, status?: MemberStatus, role?: string, tag?: Array<string>, enrollmentYear?: number, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.apiMembersExportGet(format, columns, includeSensitive, status, role, tag, enrollmentYear, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * サークルメンバーのサマリ情報一覧を返します。 status で在籍中（active）と卒業生（alumni）を切り替えられます。省略時は在籍中のみ（役員は全員）です。 承認待ち・利用停止のメンバーは役員にだけ返します。 
          * @summary メンバー一覧を取得する
+         * @param {MemberStatus} [status] 在籍状況で絞り込む
+         * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        apiMembersGet(options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberSummary>> {
-            return localVarFp.apiMembersGet(options).then((request) => request(axios, basePath));
+        apiMembersGet(status?: MemberStatus, tag?: Array<string>, options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberSummary>> {
+            return localVarFp.apiMembersGet(status, tag, options).then((request) => request(axios, basePath));
         },
         /**
-         * 指定したメンバーの詳細情報を返します。
+         * 指定したメンバーの詳細情報を返します。承認待ち・利用停止のメンバーは役員と本人以外に、削除済みのメンバーは役員以外に見つからない扱い（404）になります。
          * @summary メンバー詳細を取得する
          * @param {string} id 
          * @param {*} [options] Override http request option.
@@ -377,7 +2770,61 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
             return localVarFp.apiMembersIdGet(id, options).then((request) => request(axios, basePath));
         },
         /**
-         * 新しいメンバーを登録します。
+         * スキル・興味タグの重なりが大きい順に他のメンバーを返します。新入生がメンターを探すのに使います。 多くの人が持っているタグより、持っている人の少ないタグの一致を重く見ます。タグが 1 つも重ならないメンバーは返しません。 
+         * @summary 興味の近いメンバーを取得する
+         * @param {string} id 
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdSimilarGet(id: string, limit?: number, options?: RawAxiosRequestConfig): AxiosPromise<Array<SimilarMember>> {
+            return localVarFp.apiMembersIdSimilarGet(id, limit, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 役職の任期を記録します。academic_year を指定するとその年度（4月1日〜翌3月31日）を任期とし、 年度の途中で交代した場合は start_date / end_date で指定します。 
+         * @summary メンバーの任期を記録する（役員のみ）
+         * @param {string} id 
+         * @param {RoleTermCreate} roleTermCreate 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdTermsPost(id: string, roleTermCreate: RoleTermCreate, options?: RawAxiosRequestConfig): AxiosPromise<RoleTerm> {
+            return localVarFp.apiMembersIdTermsPost(id, roleTermCreate, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary 任期の記録を削除する（役員のみ）
+         * @param {string} id 
+         * @param {string} termId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdTermsTermIdDelete(id: string, termId: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiMembersIdTermsTermIdDelete(id, termId, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * スマートフォンの連絡先に登録できる vCard（4.0）を返します。名前・ニックネーム・アイコン・役職と、 リンク・連携アカウント・SNSアカウントのURLを含めます。 本人以外には visibility の設定に従い、name が false なら名前の代わりにニックネームを、SNSは公開しているものだけを含めます。 メールアドレスと学籍番号は含めません。 
+         * @summary メンバーの連絡先（vCard）を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersIdVcardGet(id: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.apiMembersIdVcardGet(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 新入生などをCSVでまとめて登録します。1行目は見出し行で、列の順番は自由です。 列は name（名前）、nickname（ニックネーム）、student_id（学籍番号）、department（学部・学科）、year（学年または入学年度）、roles（班・役職。複数は「;」区切り）で、 日本語の見出し（名前・ニックネーム・学籍番号・学部・学年・役職）も使えます。文字コードはUTF-8（BOM付きも可）です。  dry_run が true（既定）のときは登録せずに行ごとの確認結果だけを返します。結果を確認してから dry_run=false で送ると、 エラーのない行だけを在籍中のメンバーとして登録します。学籍番号が登録済みのメンバーやCSV内の別の行と重なる行は登録しません。 
+         * @summary CSVからメンバーをまとめて登録する（役員のみ）
+         * @param {string} body 
+         * @param {boolean} [dryRun] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersImportPost(body: string, dryRun?: boolean, options?: RawAxiosRequestConfig): AxiosPromise<ImportReport> {
+            return localVarFp.apiMembersImportPost(body, dryRun, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 新しいメンバーを登録します。 役員は招待コードなしで登録できます。それ以外は、外部IDでログインして未登録と判定されたあと（onboarding_token Cookie）に、有効な招待コードを添えて自分を登録します。 自分を登録した場合はそのままログイン状態になります。 
          * @summary メンバーを登録する
          * @param {MemberCreate} memberCreate 
          * @param {*} [options] Override http request option.
@@ -386,6 +2833,17 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
         apiMembersPost(memberCreate: MemberCreate, options?: RawAxiosRequestConfig): AxiosPromise<MemberCreateResponse> {
             return localVarFp.apiMembersPost(memberCreate, options).then((request) => request(axios, basePath));
         },
+        /**
+         * 名前・ニックネーム・役職・自己紹介からメンバーを検索し、一致度の高い順に返します。 全角・半角、ひらがな・カタカナの違いは無視し、かなの名前やニックネームはローマ字でも検索できます。 空白で区切ると、すべての語に一致するメンバーだけを返します。役員以外には承認待ち・利用停止のメンバーは返しません。 
+         * @summary メンバーを検索する
+         * @param {string} q 検索語
+         * @param {number} [limit] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiMembersSearchGet(q: string, limit?: number, options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberSearchHit>> {
+            return localVarFp.apiMembersSearchGet(q, limit, options).then((request) => request(axios, basePath));
+        },
         /**
          * 現在登録されているユーザーの基本情報を返します。
          * @summary 基本情報を取得する
@@ -396,7 +2854,7 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
             return localVarFp.apiProfileBasicInfoGet(options).then((request) => request(axios, basePath));
         },
         /**
-         * 学籍番号や名前、自己紹介などの基本情報を編集します。
+         * 学籍番号や名前、自己紹介などの基本情報を編集します。SNSアカウントは /api/me/social で更新します。
          * @summary 基本情報を更新する
          * @param {BasicInfo} basicInfo 
          * @param {*} [options] Override http request option.
@@ -405,22 +2863,283 @@ export const DefaultApiFactory = function (configuration?: Configuration, basePa
         apiProfileBasicInfoPut(basicInfo: BasicInfo, options?: RawAxiosRequestConfig): AxiosPromise<UpdateResponse> {
             return localVarFp.apiProfileBasicInfoPut(basicInfo, options).then((request) => request(axios, basePath));
         },
+        /**
+         * メンバーに付いているタグと、そのタグを持つメンバー数を多い順に返します。役員以外には承認待ち・利用停止のメンバーを数えません。
+         * @summary スキル・興味タグの一覧を取得する
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTagsGet(options?: RawAxiosRequestConfig): AxiosPromise<Array<TagCount>> {
+            return localVarFp.apiTagsGet(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 登録されている班と役職を表示順に返します。kind で班（team）か役職（officer）に絞り込めます。
+         * @summary 班・役職の一覧を取得する
+         * @param {TeamKind} [kind] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsGet(kind?: TeamKind, options?: RawAxiosRequestConfig): AxiosPromise<Array<Team>> {
+            return localVarFp.apiTeamsGet(kind, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 所属しているメンバーがいる間は削除できません。
+         * @summary 班・役職を削除する（役員のみ）
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdDelete(id: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.apiTeamsIdDelete(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 
+         * @summary 班・役職を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdGet(id: string, options?: RawAxiosRequestConfig): AxiosPromise<Team> {
+            return localVarFp.apiTeamsIdGet(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 指定した班・役職の任期を新しい順に返します。サークルの歴史ページ用です。退会したメンバーも当時の名前で残ります。
+         * @summary 役職の歴代就任者を取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdHoldersGet(id: string, options?: RawAxiosRequestConfig): AxiosPromise<Array<RoleTerm>> {
+            return localVarFp.apiTeamsIdHoldersGet(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 役員以外には在籍中（active）のメンバーだけを返します。
+         * @summary 班・役職に所属するメンバーを取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdMembersGet(id: string, options?: RawAxiosRequestConfig): AxiosPromise<Array<MemberSummary>> {
+            return localVarFp.apiTeamsIdMembersGet(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 名前を変えると、所属メンバーの表示上の役職名も合わせて更新します。 役員の判定に使う役職（設定の auth.officer_roles にある名前）は、その名前からも、その名前へも変えられません。 
+         * @summary 班・役職を更新する（役員のみ）
+         * @param {string} id 
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdPut(id: string, teamInput: TeamInput, options?: RawAxiosRequestConfig): AxiosPromise<Team> {
+            return localVarFp.apiTeamsIdPut(id, teamInput, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 班・役職に所属するメンバーの vCard（4.0）を1つの .vcf ファイルにまとめて返します。 対象は /api/teams/{id}/members と同じで、各メンバーの中身は /api/members/{id}/vcard と同じ規則で作ります。 
+         * @summary 班・役職のメンバーの連絡先をまとめて取得する
+         * @param {string} id 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsIdVcardGet(id: string, options?: RawAxiosRequestConfig): AxiosPromise<string> {
+            return localVarFp.apiTeamsIdVcardGet(id, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 名前は全角・半角や大文字・小文字の違いを無視して重複を確認します。
+         * @summary 班・役職を追加する（役員のみ）
+         * @param {TeamInput} teamInput 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        apiTeamsPost(teamInput: TeamInput, options?: RawAxiosRequestConfig): AxiosPromise<Team> {
+            return localVarFp.apiTeamsPost(teamInput, options).then((request) => request(axios, basePath));
+        },
     };
 };
 
-/**
- * DefaultApi - object-oriented interface
- * @export
- * @class DefaultApi
- * @extends {BaseAPI}
- */
-export class DefaultApi extends BaseAPI {
+/**
+ * DefaultApi - object-oriented interface
+ * @export
+ * @class DefaultApi
+ * @extends {BaseAPI}
+ */
+export class DefaultApi extends BaseAPI {
+    /**
+     * 発行済みの招待コードを新しい順に返します。statusで未使用・使用済み・期限切れに絞り込めます。
+     * @summary 招待コードの一覧を取得する（役員のみ）
+     * @param {InvitationStatus} [status] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminInvitationsGet(status?: InvitationStatus, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminInvitationsGet(status, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 使用回数の上限と有効期限付きの招待コードを発行します。役職・学年を指定すると、登録時にその値が使われます。
+     * @summary 招待コードを発行する（役員のみ）
+     * @param {InvitationCreate} invitationCreate 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminInvitationsPost(invitationCreate: InvitationCreate, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminInvitationsPost(invitationCreate, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 削除済みのメンバーを、削除が新しい順に返します。 削除済みのメンバーは保持期間（deleted_member_retention）を過ぎると完全に削除され、復元できなくなります。 
+     * @summary 削除済みのメンバーを取得する（役員のみ）
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersDeletedGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersDeletedGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 同じ人が二重に登録されていそうなメンバーの組を、疑わしい順に返します。 学籍番号・LINEユーザーID・メールアドレスの一致と、名前の近さ（全角・半角、ひらがな・カタカナ、空白の違いを無視し、ローマ字でも比べる）から判定します。 
+     * @summary 重複していそうなメンバーを取得する（役員のみ）
+     * @param {number} [minScore] この値以上の組だけを返す
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersDuplicatesGet(minScore?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersDuplicatesGet(minScore, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 承認待ち（pending）のメンバーを在籍中（active）にします。
+     * @summary 承認待ちのメンバーを承認する（役員のみ）
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdApprovePost(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdApprovePost(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * メンバーを削除済みにします。一覧・検索・詳細に出なくなり、本人もログインできなくなります。 イベントの参加履歴や役職の任期は残るので、保持期間のうちは restore で元に戻せます。 
+     * @summary メンバーを削除する（役員のみ）
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdDelete(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdDelete(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * source_id のメンバーを {id} のメンバーに統合します。{id} 側の値を優先し、空の項目だけを source_id 側から補います。 役職・タグ・リンク・イベントの参加履歴はまとめ、ログインID・役職の任期・招待コードの利用記録も {id} に移します。 削除済みのメンバーは統合できません（復元してから統合してください）。 source_id のメンバーは一覧から消え、詳細を取得すると {id} へリダイレクトされます。 
+     * @summary 重複したメンバーを統合する（役員のみ）
+     * @param {string} id 
+     * @param {MemberMerge} memberMerge 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdMergePost(id: string, memberMerge: MemberMerge, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdMergePost(id, memberMerge, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 承認待ち（pending）のメンバーを、ログインIDの紐づけごと削除します。本人は招待コードで登録し直せます。
+     * @summary 承認待ちのメンバーを却下する（役員のみ）
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdRejectPost(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdRejectPost(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary 削除したメンバーを元に戻す（役員のみ）
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdRestorePost(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdRestorePost(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 在籍中・卒業生・利用停止を切り替えます。承認待ちへの変更や承認待ちからの変更は approve / reject を使ってください。
+     * @summary メンバーのステータスを変更する（役員のみ）
+     * @param {string} id 
+     * @param {MemberStatusUpdate} memberStatusUpdate 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersIdStatusPut(id: string, memberStatusUpdate: MemberStatusUpdate, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersIdStatusPut(id, memberStatusUpdate, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 本人が登録して承認待ち（pending）のメンバーを、登録が古い順に返します。
+     * @summary 承認待ちのメンバーを取得する（役員のみ）
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAdminMembersPendingGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAdminMembersPendingGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * codeをトークンに交換してプロバイダのユーザー（subject）を取得し、member_identities で対応するメンバーを探します。 メンバーが見つかればセッションCookie（auth_token）を発行します。 見つからず、すでにログイン中であれば、そのメンバーにこのプロバイダのIDを追加で紐づけます。 
+     * @summary 外部IDプロバイダのコールバック
+     * @param {string} provider プロバイダ名（例 line / google）
+     * @param {string} code 認可コード
+     * @param {string} [state] CSRF対策のstate値
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAuthProviderCallbackGet(provider: string, code: string, state?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAuthProviderCallbackGet(provider, code, state, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * stateとnonceを発行してCookieに保存し、指定したプロバイダ（line、または設定ファイルの identity_providers に書いたもの）の認可画面へリダイレクトします。
+     * @summary 外部IDプロバイダでのログインを開始する
+     * @param {string} provider プロバイダ名（例 line / google）
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiAuthProviderStartGet(provider: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiAuthProviderStartGet(provider, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * /api/auth/line/start と同じ処理です。stateとnonceを発行してCookieに保存し、openid/profile/emailスコープを付けたLINEの認可画面へリダイレクトします。新しいクライアントは /api/auth/line/start を使ってください。
+     * @summary LINEログインを開始する
+     * @param {*} [options] Override http request option.
+     * @deprecated
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiLineLoginGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiLineLoginGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
-     * LINEのOAuthコールバックURLとして、付与されたcodeをアクセストークンに交換し、認証済みユーザー情報を返します。
+     * /api/auth/line/callback と同じ処理です。付与されたcodeをトークンに交換し、IDトークン（署名・iss・aud・nonce・exp）を検証したうえで、member_identities で対応するメンバーとしてログインさせます。メンバーがいれば、検証済みのメールアドレスを保存します。新しいクライアントは /api/auth/line/callback を使ってください。
      * @summary LINE OAuthコールバック
      * @param {string} code LINE OAuth認可コード
      * @param {string} [state] CSRF対策のstate値
      * @param {*} [options] Override http request option.
+     * @deprecated
      * @throws {RequiredError}
      * @memberof DefaultApi
      */
@@ -429,18 +3148,166 @@ export class DefaultApi extends BaseAPI {
     }
 
     /**
-     * サークルメンバーのサマリ情報一覧を返します。
+     * 自分のメンバー情報とログインIDの紐づけを削除し、ログイン中のセッションをすべて無効にします。元には戻せません。 役職の任期は団体の歴代記録として残しますが、名前は「退会したメンバー」に置き換えます。 
+     * @summary 退会して自分のデータを削除する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeDelete(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeDelete(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 保存している自分のデータをJSONファイルにしてZIPでまとめて返します。   - profile.json: 他のメンバーに見えるプロフィール（自分が見たときのもの）   - member.json: メンバー情報として保存しているすべての項目（メールアドレス・学籍番号などを含む）   - identities.json: ログインに使う外部IDの紐づけ   - invitations.json: 自分が発行した招待コードと、自分が使って登録した招待コード（他のメンバーの利用記録は含みません）   - audit.json: 自分についての記録（役職の任期の登録、招待コードの発行・利用、ログインIDの紐づけ） 
+     * @summary 自分のデータをまとめてダウンロードする
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeExportGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeExportGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 付与されたcodeをアクセストークンに交換してDiscordユーザーを取得し、ログイン中のメンバーに紐づけます。accounts.discordは自動的にtrueになります。
+     * @summary Discordアカウント連携のコールバック
+     * @param {string} code Discord OAuth認可コード
+     * @param {string} [state] CSRF対策のstate値
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksDiscordCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksDiscordCallbackGet(code, state, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ログイン中のメンバーからDiscordアカウントの紐づけを外し、accounts.discordをfalseにします。
+     * @summary Discordアカウント連携を解除する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksDiscordDelete(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksDiscordDelete(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ログイン中のメンバーについて、stateを発行してCookieに保存し、Discordの認可画面へリダイレクトします。
+     * @summary Discordアカウント連携を開始する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksDiscordStartGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksDiscordStartGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 付与されたcodeをアクセストークンに交換してGitHubユーザーを取得し、ログイン中のメンバーにloginとIDを紐づけます。accounts.githubは自動的にtrueになります。
+     * @summary GitHubアカウント連携のコールバック
+     * @param {string} code GitHub OAuth認可コード
+     * @param {string} [state] CSRF対策のstate値
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksGithubCallbackGet(code: string, state?: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksGithubCallbackGet(code, state, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ログイン中のメンバーからGitHubアカウントの紐づけを外し、accounts.githubをfalseにします。linksに自動で載せていたプロフィールURLも消えます。
+     * @summary GitHubアカウント連携を解除する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksGithubDelete(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksGithubDelete(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ログイン中のメンバーについて、stateを発行してCookieに保存し、GitHubの認可画面へリダイレクトします。
+     * @summary GitHubアカウント連携を開始する
+     * @param {boolean} [showOnProfile] 連携後、GitHubのプロフィールURLをメンバー詳細のlinksに自動で載せるか
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeLinksGithubStartGet(showOnProfile?: boolean, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeLinksGithubStartGet(showOnProfile, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary 自分のSNSアカウント設定を取得する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeSocialGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeSocialGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * X・Instagram・GitHubのユーザー名と個人サイトのURLを登録します。 「@tanataro」や「https://x.com/tanataro」のようなURLでも受け付け、ユーザー名にそろえて保存します。 他のメンバーには visibility が true のものだけが表示されます。 
+     * @summary 自分のSNSアカウント設定を更新する
+     * @param {SocialProfile} socialProfile 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeSocialPut(socialProfile: SocialProfile, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeSocialPut(socialProfile, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * タグを丸ごと置き換えます。表記は正規化して保存します（「ＰＹＴＨＯＮ」「py」は「python」）。
+     * @summary 自分のスキル・興味タグを更新する
+     * @param {TagsUpdate} tagsUpdate 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMeTagsPut(tagsUpdate: TagsUpdate, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMeTagsPut(tagsUpdate, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 大学への提出や共有用に、メンバー名簿をCSV・Excel（xlsx）・JSONで書き出します。 columns で列と並び順を選べます（省略時は学籍番号・メールアドレス以外の列）。CSVはExcelで開けるようBOM付きのUTF-8で、 roles 列の形式は一括登録（/api/members/import）と同じです。  学籍番号（student_id）とメールアドレス（email）は個人情報のため、include_sensitive=true を明示したときだけ書き出せます。 
+     * @summary メンバー名簿を書き出す（役員のみ）
+     * @param {ApiMembersExportGetFormatEnum} [format] 
+     * @param {Array<ApiMembersExportGetColumnsEnum>} [columns] 書き出す列（カンマ区切り、指定した順に並ぶ）
+     * @param {boolean} [includeSensitive] true のときだけ student_id・email の列を書き出せる
+     * @param {MemberStatus} [status] 在籍状況で絞り込む（省略時は全員）
+     * @param {string} [role] 班・役職（名前かID）で絞り込む
+     * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
+     * @param {number} [enrollmentYear] 入学年度で絞り込む
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersExportGet(format?: ApiMembersExportGetFormatEnum, columns?: Array<ApiMembersExportGetColumnsEnum>, includeSensitive?: boolean, status?: MemberStatus, role?: string, tag?: Array<string>, enrollmentYear?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersExportGet(format, columns, includeSensitive, status, role, tag, enrollmentYear, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * サークルメンバーのサマリ情報一覧を返します。 status で在籍中（active）と卒業生（alumni）を切り替えられます。省略時は在籍中のみ（役員は全員）です。 承認待ち・利用停止のメンバーは役員にだけ返します。 
      * @summary メンバー一覧を取得する
+     * @param {MemberStatus} [status] 在籍状況で絞り込む
+     * @param {Array<string>} [tag] スキル・興味タグで絞り込む（複数指定するとすべてを持つメンバー）
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      * @memberof DefaultApi
      */
-    public apiMembersGet(options?: RawAxiosRequestConfig) {
-        return DefaultApiFp(this.configuration).apiMembersGet(options).then((request) => request(this.axios, this.basePath));
+    public apiMembersGet(status?: MemberStatus, tag?: Array<string>, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersGet(status, tag, options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
-     * 指定したメンバーの詳細情報を返します。
+     * 指定したメンバーの詳細情報を返します。承認待ち・利用停止のメンバーは役員と本人以外に、削除済みのメンバーは役員以外に見つからない扱い（404）になります。
      * @summary メンバー詳細を取得する
      * @param {string} id 
      * @param {*} [options] Override http request option.
@@ -452,7 +3319,71 @@ export class DefaultApi extends BaseAPI {
     }
 
     /**
-     * 新しいメンバーを登録します。
+     * スキル・興味タグの重なりが大きい順に他のメンバーを返します。新入生がメンターを探すのに使います。 多くの人が持っているタグより、持っている人の少ないタグの一致を重く見ます。タグが 1 つも重ならないメンバーは返しません。 
+     * @summary 興味の近いメンバーを取得する
+     * @param {string} id 
+     * @param {number} [limit] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersIdSimilarGet(id: string, limit?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersIdSimilarGet(id, limit, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 役職の任期を記録します。academic_year を指定するとその年度（4月1日〜翌3月31日）を任期とし、 年度の途中で交代した場合は start_date / end_date で指定します。 
+     * @summary メンバーの任期を記録する（役員のみ）
+     * @param {string} id 
+     * @param {RoleTermCreate} roleTermCreate 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersIdTermsPost(id: string, roleTermCreate: RoleTermCreate, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersIdTermsPost(id, roleTermCreate, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary 任期の記録を削除する（役員のみ）
+     * @param {string} id 
+     * @param {string} termId 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersIdTermsTermIdDelete(id: string, termId: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersIdTermsTermIdDelete(id, termId, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * スマートフォンの連絡先に登録できる vCard（4.0）を返します。名前・ニックネーム・アイコン・役職と、 リンク・連携アカウント・SNSアカウントのURLを含めます。 本人以外には visibility の設定に従い、name が false なら名前の代わりにニックネームを、SNSは公開しているものだけを含めます。 メールアドレスと学籍番号は含めません。 
+     * @summary メンバーの連絡先（vCard）を取得する
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersIdVcardGet(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersIdVcardGet(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 新入生などをCSVでまとめて登録します。1行目は見出し行で、列の順番は自由です。 列は name（名前）、nickname（ニックネーム）、student_id（学籍番号）、department（学部・学科）、year（学年または入学年度）、roles（班・役職。複数は「;」区切り）で、 日本語の見出し（名前・ニックネーム・学籍番号・学部・学年・役職）も使えます。文字コードはUTF-8（BOM付きも可）です。  dry_run が true（既定）のときは登録せずに行ごとの確認結果だけを返します。結果を確認してから dry_run=false で送ると、 エラーのない行だけを在籍中のメンバーとして登録します。学籍番号が登録済みのメンバーやCSV内の別の行と重なる行は登録しません。 
+     * @summary CSVからメンバーをまとめて登録する（役員のみ）
+     * @param {string} body 
+     * @param {boolean} [dryRun] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersImportPost(body: string, dryRun?: boolean, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersImportPost(body, dryRun, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 新しいメンバーを登録します。 役員は招待コードなしで登録できます。それ以外は、外部IDでログインして未登録と判定されたあと（onboarding_token Cookie）に、有効な招待コードを添えて自分を登録します。 自分を登録した場合はそのままログイン状態になります。 
      * @summary メンバーを登録する
      * @param {MemberCreate} memberCreate 
      * @param {*} [options] Override http request option.
@@ -463,6 +3394,19 @@ export class DefaultApi extends BaseAPI {
         return DefaultApiFp(this.configuration).apiMembersPost(memberCreate, options).then((request) => request(this.axios, this.basePath));
     }
 
+    /**
+     * 名前・ニックネーム・役職・自己紹介からメンバーを検索し、一致度の高い順に返します。 全角・半角、ひらがな・カタカナの違いは無視し、かなの名前やニックネームはローマ字でも検索できます。 空白で区切ると、すべての語に一致するメンバーだけを返します。役員以外には承認待ち・利用停止のメンバーは返しません。 
+     * @summary メンバーを検索する
+     * @param {string} q 検索語
+     * @param {number} [limit] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiMembersSearchGet(q: string, limit?: number, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiMembersSearchGet(q, limit, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 現在登録されているユーザーの基本情報を返します。
      * @summary 基本情報を取得する
@@ -475,7 +3419,7 @@ export class DefaultApi extends BaseAPI {
     }
 
     /**
-     * 学籍番号や名前、自己紹介などの基本情報を編集します。
+     * 学籍番号や名前、自己紹介などの基本情報を編集します。SNSアカウントは /api/me/social で更新します。
      * @summary 基本情報を更新する
      * @param {BasicInfo} basicInfo 
      * @param {*} [options] Override http request option.
@@ -485,5 +3429,140 @@ export class DefaultApi extends BaseAPI {
     public apiProfileBasicInfoPut(basicInfo: BasicInfo, options?: RawAxiosRequestConfig) {
         return DefaultApiFp(this.configuration).apiProfileBasicInfoPut(basicInfo, options).then((request) => request(this.axios, this.basePath));
     }
+
+    /**
+     * メンバーに付いているタグと、そのタグを持つメンバー数を多い順に返します。役員以外には承認待ち・利用停止のメンバーを数えません。
+     * @summary スキル・興味タグの一覧を取得する
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTagsGet(options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTagsGet(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 登録されている班と役職を表示順に返します。kind で班（team）か役職（officer）に絞り込めます。
+     * @summary 班・役職の一覧を取得する
+     * @param {TeamKind} [kind] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsGet(kind?: TeamKind, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsGet(kind, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 所属しているメンバーがいる間は削除できません。
+     * @summary 班・役職を削除する（役員のみ）
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdDelete(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdDelete(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 
+     * @summary 班・役職を取得する
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdGet(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdGet(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 指定した班・役職の任期を新しい順に返します。サークルの歴史ページ用です。退会したメンバーも当時の名前で残ります。
+     * @summary 役職の歴代就任者を取得する
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdHoldersGet(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdHoldersGet(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 役員以外には在籍中（active）のメンバーだけを返します。
+     * @summary 班・役職に所属するメンバーを取得する
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdMembersGet(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdMembersGet(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 名前を変えると、所属メンバーの表示上の役職名も合わせて更新します。 役員の判定に使う役職（設定の auth.officer_roles にある名前）は、その名前からも、その名前へも変えられません。 
+     * @summary 班・役職を更新する（役員のみ）
+     * @param {string} id 
+     * @param {TeamInput} teamInput 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdPut(id: string, teamInput: TeamInput, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdPut(id, teamInput, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 班・役職に所属するメンバーの vCard（4.0）を1つの .vcf ファイルにまとめて返します。 対象は /api/teams/{id}/members と同じで、各メンバーの中身は /api/members/{id}/vcard と同じ規則で作ります。 
+     * @summary 班・役職のメンバーの連絡先をまとめて取得する
+     * @param {string} id 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsIdVcardGet(id: string, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsIdVcardGet(id, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 名前は全角・半角や大文字・小文字の違いを無視して重複を確認します。
+     * @summary 班・役職を追加する（役員のみ）
+     * @param {TeamInput} teamInput 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof DefaultApi
+     */
+    public apiTeamsPost(teamInput: TeamInput, options?: RawAxiosRequestConfig) {
+        return DefaultApiFp(this.configuration).apiTeamsPost(teamInput, options).then((request) => request(this.axios, this.basePath));
+    }
 }
 
+/**
+ * @export
+ */
+export const ApiMembersExportGetFormatEnum = {
+    Csv: 'csv',
+    Xlsx: 'xlsx',
+    Json: 'json'
+} as const;
+export type ApiMembersExportGetFormatEnum = typeof ApiMembersExportGetFormatEnum[keyof typeof ApiMembersExportGetFormatEnum];
+/**
+ * @export
+ */
+export const ApiMembersExportGetColumnsEnum = {
+    Id: 'id',
+    Name: 'name',
+    Nickname: 'nickname',
+    StudentId: 'student_id',
+    Email: 'email',
+    Department: 'department',
+    Year: 'year',
+    EnrollmentYear: 'enrollment_year',
+    Status: 'status',
+    Roles: 'roles',
+    Tags: 'tags',
+    CreatedAt: 'created_at'
+} as const;
+export type ApiMembersExportGetColumnsEnum = typeof ApiMembersExportGetColumnsEnum[keyof typeof ApiMembersExportGetColumnsEnum];
@@ -0,0 +1,48 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface AccountLink
+ */
+export interface AccountLink {
+    /**
+     * 連携先（discord / github）
+     * @type {string}
+     * @memberof AccountLink
+     */
+    'provider': string;
+    /**
+     * 連携先でのユーザーID
+     * @type {string}
+     * @memberof AccountLink
+     */
+    'account_id': string;
+    /**
+     * 連携先でのユーザー名
+     * @type {string}
+     * @memberof AccountLink
+     */
+    'username': string;
+    /**
+     * 連携先のプロフィールURL（GitHubのみ）
+     * @type {string}
+     * @memberof AccountLink
+     */
+    'profile_url'?: string;
+}
+
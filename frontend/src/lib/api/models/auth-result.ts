@@ -0,0 +1,60 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface AuthResult
+ */
+export interface AuthResult {
+    /**
+     * ログインに使ったプロバイダ
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'provider': string;
+    /**
+     * プロバイダでのユーザーID
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'subject': string;
+    /**
+     * プロバイダでの表示名
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'display_name': string;
+    /**
+     * プロバイダが返したメールアドレス
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'email'?: string;
+    /**
+     * プロバイダでのプロフィール画像URL
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'picture_url'?: string;
+    /**
+     * ログインしたメンバーのID（未登録なら省略）
+     * @type {string}
+     * @memberof AuthResult
+     */
+    'member_id'?: string;
+}
+
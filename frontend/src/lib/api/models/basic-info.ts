@@ -59,6 +59,18 @@ export interface BasicInfo {
      * @memberof BasicInfo
      */
     'self_introduction': string;
+    /**
+     * 入学年度。学年は年度（4月始まり）から自動で計算します
+     * @type {number}
+     * @memberof BasicInfo
+     */
+    'enrollment_year'?: number;
+    /**
+     * 修業年限（学部4、修士2など）
+     * @type {number}
+     * @memberof BasicInfo
+     */
+    'program_years'?: number;
     /**
      * 
      * @type {Visibility}
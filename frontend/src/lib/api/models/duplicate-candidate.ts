@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberSummary } from './member-summary';
+
+/**
+ * 
+ * @export
+ * @interface DuplicateCandidate
+ */
+export interface DuplicateCandidate {
+    /**
+     * 
+     * @type {Array<MemberSummary>}
+     * @memberof DuplicateCandidate
+     */
+    'members': Array<MemberSummary>;
+    /**
+     * 同じ人らしさ（0〜1）
+     * @type {number}
+     * @memberof DuplicateCandidate
+     */
+    'score': number;
+    /**
+     * 一致・類似した項目
+     * @type {Array<string>}
+     * @memberof DuplicateCandidate
+     */
+    'reasons': Array<DuplicateCandidateReasonsEnum>;
+}
+
+export const DuplicateCandidateReasonsEnum = {
+    StudentId: 'student_id',
+    LineUserId: 'line_user_id',
+    Email: 'email',
+    Name: 'name'
+} as const;
+
+export type DuplicateCandidateReasonsEnum = typeof DuplicateCandidateReasonsEnum[keyof typeof DuplicateCandidateReasonsEnum];
+
+
@@ -0,0 +1,57 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { ImportRowResult } from './import-row-result';
+
+/**
+ * 
+ * @export
+ * @interface ImportReport
+ */
+export interface ImportReport {
+    /**
+     * true なら何も登録していない
+     * @type {boolean}
+     * @memberof ImportReport
+     */
+    'dry_run': boolean;
+    /**
+     * 見出し行を除いた行数
+     * @type {number}
+     * @memberof ImportReport
+     */
+    'total': number;
+    /**
+     * エラーのない行数
+     * @type {number}
+     * @memberof ImportReport
+     */
+    'valid': number;
+    /**
+     * 登録した人数（dry_run のときは 0）
+     * @type {number}
+     * @memberof ImportReport
+     */
+    'created': number;
+    /**
+     * 
+     * @type {Array<ImportRowResult>}
+     * @memberof ImportReport
+     */
+    'rows': Array<ImportRowResult>;
+}
+
@@ -0,0 +1,69 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface ImportRowResult
+ */
+export interface ImportRowResult {
+    /**
+     * CSVの行番号（見出し行が1）
+     * @type {number}
+     * @memberof ImportRowResult
+     */
+    'line': number;
+    /**
+     * 
+     * @type {string}
+     * @memberof ImportRowResult
+     */
+    'name'?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ImportRowResult
+     */
+    'student_id'?: string;
+    /**
+     * valid は登録できる行（dry_run のとき）、created は登録した行、invalid はエラーのある行
+     * @type {string}
+     * @memberof ImportRowResult
+     */
+    'status': ImportRowResultStatusEnum;
+    /**
+     * 登録したメンバーのID（created のときのみ）
+     * @type {string}
+     * @memberof ImportRowResult
+     */
+    'id'?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ImportRowResult
+     */
+    'errors': Array<string>;
+}
+
+export const ImportRowResultStatusEnum = {
+    Valid: 'valid',
+    Invalid: 'invalid',
+    Created: 'created'
+} as const;
+
+export type ImportRowResultStatusEnum = typeof ImportRowResultStatusEnum[keyof typeof ImportRowResultStatusEnum];
+
+
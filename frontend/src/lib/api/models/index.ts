@@ -1,6 +1,13 @@
+export * from './account-link';
+export * from './auth-result';
 export * from './basic-info';
-export * from './line-oauth-response';
-export * from './line-user';
+export * from './duplicate-candidate';
+export * from './import-report';
+export * from './import-row-result';
+export * from './invitation';
+export * from './invitation-create';
+export * from './invitation-redemptions';
+export * from './invitation-status';
 export * from './member-create';
 export * from './member-create-accounts';
 export * from './member-create-response';
@@ -8,6 +15,22 @@ export * from './member-detail';
 export * from './member-detail-all-of-accounts';
 export * from './member-detail-all-of-events';
 export * from './member-detail-all-of-links';
+export * from './member-merge';
+export * from './member-search-hit';
+export * from './member-social';
+export * from './member-status';
+export * from './member-status-update';
 export * from './member-summary';
+export * from './role-term';
+export * from './role-term-create';
+export * from './similar-member';
+export * from './social-account';
+export * from './social-handles';
+export * from './social-profile';
+export * from './tag-count';
+export * from './tags-update';
+export * from './team';
+export * from './team-input';
+export * from './team-kind';
 export * from './update-response';
 export * from './visibility';
@@ -0,0 +1,54 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface InvitationCreate
+ */
+export interface InvitationCreate {
+    /**
+     * 使用できる回数
+     * @type {number}
+     * @memberof InvitationCreate
+     */
+    'max_uses'?: number;
+    /**
+     * 有効期間（時間）
+     * @type {number}
+     * @memberof InvitationCreate
+     */
+    'expires_in_hours'?: number;
+    /**
+     * 登録時に付与する班・役職の名前かID。登録済みの班・役職でなければエラーになります
+     * @type {Array<string>}
+     * @memberof InvitationCreate
+     */
+    'roles'?: Array<string>;
+    /**
+     * 登録時に設定する学年
+     * @type {string}
+     * @memberof InvitationCreate
+     */
+    'year'?: string;
+    /**
+     * 役員向けのメモ（誰に渡したかなど）
+     * @type {string}
+     * @memberof InvitationCreate
+     */
+    'note'?: string;
+}
+
@@ -0,0 +1,36 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface InvitationRedemptions
+ */
+export interface InvitationRedemptions {
+    /**
+     * 
+     * @type {string}
+     * @memberof InvitationRedemptions
+     */
+    'member_id': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof InvitationRedemptions
+     */
+    'redeemed_at': string;
+}
+
@@ -0,0 +1,31 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * outstanding=使用可能、redeemed=使用回数の上限に達した、expired=期限切れ
+ * @export
+ * @enum {string}
+ */
+
+export const InvitationStatus = {
+    Outstanding: 'outstanding',
+    Redeemed: 'redeemed',
+    Expired: 'expired'
+} as const;
+
+export type InvitationStatus = typeof InvitationStatus[keyof typeof InvitationStatus];
+
+
@@ -0,0 +1,96 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { InvitationRedemptions } from './invitation-redemptions';
+// May contain unused imports in some cases
+// @ts-ignore
+import type { InvitationStatus } from './invitation-status';
+
+/**
+ * 
+ * @export
+ * @interface Invitation
+ */
+export interface Invitation {
+    /**
+     * 
+     * @type {string}
+     * @memberof Invitation
+     */
+    'code': string;
+    /**
+     * 
+     * @type {number}
+     * @memberof Invitation
+     */
+    'max_uses': number;
+    /**
+     * 
+     * @type {number}
+     * @memberof Invitation
+     */
+    'uses': number;
+    /**
+     * 
+     * @type {string}
+     * @memberof Invitation
+     */
+    'expires_at': string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof Invitation
+     */
+    'roles': Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof Invitation
+     */
+    'year'?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Invitation
+     */
+    'note'?: string;
+    /**
+     * 発行した役員のメンバーID
+     * @type {string}
+     * @memberof Invitation
+     */
+    'created_by': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Invitation
+     */
+    'created_at': string;
+    /**
+     * 
+     * @type {InvitationStatus}
+     * @memberof Invitation
+     */
+    'status': InvitationStatus;
+    /**
+     * 
+     * @type {Array<InvitationRedemptions>}
+     * @memberof Invitation
+     */
+    'redemptions': Array<InvitationRedemptions>;
+}
+
@@ -57,17 +57,47 @@ export interface MemberCreate {
      */
     'bio': string;
     /**
-     * 
+     * 班・役職の名前かID。登録済みの班・役職でなければエラーになります
      * @type {Array<string>}
      * @memberof MemberCreate
      */
     'roles': Array<string>;
+    /**
+     * スキル・興味タグ
+     * @type {Array<string>}
+     * @memberof MemberCreate
+     */
+    'tags'?: Array<string>;
     /**
      * 
      * @type {string}
      * @memberof MemberCreate
      */
     'avatar'?: string;
+    /**
+     * 入学年度。指定すると year の代わりに学年を自動で計算します
+     * @type {number}
+     * @memberof MemberCreate
+     */
+    'enrollment_year'?: number;
+    /**
+     * 修業年限（学部4、修士2など）
+     * @type {number}
+     * @memberof MemberCreate
+     */
+    'program_years'?: number;
+    /**
+     * 学籍番号（全角・小文字は半角・大文字にそろえます）。登録済みのメンバーと同じ番号は登録できません。API の応答には含めません
+     * @type {string}
+     * @memberof MemberCreate
+     */
+    'student_id'?: string;
+    /**
+     * 役員から受け取った招待コード（役員が登録する場合は不要）
+     * @type {string}
+     * @memberof MemberCreate
+     */
+    'invitation_code'?: string;
     /**
      * 
      * @type {MemberCreateAccounts}
@@ -24,7 +24,16 @@ import type { MemberDetailAllOfEvents } from './member-detail-all-of-events';
 import type { MemberDetailAllOfLinks } from './member-detail-all-of-links';
 // May contain unused imports in some cases
 // @ts-ignore
+import type { MemberSocial } from './member-social';
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberStatus } from './member-status';
+// May contain unused imports in some cases
+// @ts-ignore
 import type { MemberSummary } from './member-summary';
+// May contain unused imports in some cases
+// @ts-ignore
+import type { RoleTerm } from './role-term';
 
 /**
  * @type MemberDetail
@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberSummary } from './member-summary';
+
+/**
+ * 
+ * @export
+ * @interface MemberSearchHit
+ */
+export interface MemberSearchHit {
+    /**
+     * 
+     * @type {MemberSummary}
+     * @memberof MemberSearchHit
+     */
+    'member': MemberSummary;
+    /**
+     * 一致度（大きいほどよく一致）
+     * @type {number}
+     * @memberof MemberSearchHit
+     */
+    'score': number;
+    /**
+     * 一致したフィールド
+     * @type {Array<string>}
+     * @memberof MemberSearchHit
+     */
+    'matched_fields': Array<MemberSearchHitMatchedFieldsEnum>;
+}
+
+export const MemberSearchHitMatchedFieldsEnum = {
+    Name: 'name',
+    Nickname: 'nickname',
+    Roles: 'roles',
+    Bio: 'bio'
+} as const;
+
+export type MemberSearchHitMatchedFieldsEnum = typeof MemberSearchHitMatchedFieldsEnum[keyof typeof MemberSearchHitMatchedFieldsEnum];
+
+
@@ -0,0 +1,51 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { SocialAccount } from './social-account';
+
+/**
+ * 表示してよいSNSアカウント（本人以外には visibility が true のものだけ）
+ * @export
+ * @interface MemberSocial
+ */
+export interface MemberSocial {
+    /**
+     * 
+     * @type {SocialAccount}
+     * @memberof MemberSocial
+     */
+    'x'?: SocialAccount;
+    /**
+     * 
+     * @type {SocialAccount}
+     * @memberof MemberSocial
+     */
+    'instagram'?: SocialAccount;
+    /**
+     * 
+     * @type {SocialAccount}
+     * @memberof MemberSocial
+     */
+    'github'?: SocialAccount;
+    /**
+     * 
+     * @type {SocialAccount}
+     * @memberof MemberSocial
+     */
+    'website'?: SocialAccount;
+}
+
@@ -0,0 +1,33 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberStatus } from './member-status';
+
+/**
+ * 
+ * @export
+ * @interface MemberStatusUpdate
+ */
+export interface MemberStatusUpdate {
+    /**
+     * 
+     * @type {MemberStatus}
+     * @memberof MemberStatusUpdate
+     */
+    'status': MemberStatus;
+}
+
@@ -0,0 +1,32 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * pending=承認待ち、active=在籍中、alumni=卒業生、suspended=利用停止
+ * @export
+ * @enum {string}
+ */
+
+export const MemberStatus = {
+    Pending: 'pending',
+    Active: 'active',
+    Alumni: 'alumni',
+    Suspended: 'suspended'
+} as const;
+
+export type MemberStatus = typeof MemberStatus[keyof typeof MemberStatus];
+
+
@@ -13,6 +13,9 @@
  */
 
 
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberStatus } from './member-status';
 
 /**
  * 
@@ -20,6 +23,12 @@
  * @interface MemberSummary
  */
 export interface MemberSummary {
+    /**
+     * 
+     * @type {MemberStatus}
+     * @memberof MemberSummary
+     */
+    'status'?: MemberStatus;
     /**
      * 
      * @type {string}
@@ -44,6 +53,18 @@ export interface MemberSummary {
      * @memberof MemberSummary
      */
     'roles': Array<string>;
+    /**
+     * roles に対応する班・役職のID
+     * @type {Array<string>}
+     * @memberof MemberSummary
+     */
+    'role_ids'?: Array<string>;
+    /**
+     * スキル・興味タグ
+     * @type {Array<string>}
+     * @memberof MemberSummary
+     */
+    'tags'?: Array<string>;
     /**
      * 
      * @type {string}
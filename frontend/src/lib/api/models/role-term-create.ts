@@ -0,0 +1,48 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface RoleTermCreate
+ */
+export interface RoleTermCreate {
+    /**
+     * 班・役職の名前かID
+     * @type {string}
+     * @memberof RoleTermCreate
+     */
+    'role': string;
+    /**
+     * 任期の年度（start_date と同時には指定できない）
+     * @type {number}
+     * @memberof RoleTermCreate
+     */
+    'academic_year'?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTermCreate
+     */
+    'start_date'?: string;
+    /**
+     * 任期の最終日。省略すると任期中として扱う
+     * @type {string}
+     * @memberof RoleTermCreate
+     */
+    'end_date'?: string;
+}
+
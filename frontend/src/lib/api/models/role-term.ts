@@ -0,0 +1,78 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * 
+ * @export
+ * @interface RoleTerm
+ */
+export interface RoleTerm {
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'id': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'member_id': string;
+    /**
+     * 記録したときのメンバーの名前
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'member_name': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'role_id': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'role': string;
+    /**
+     * 任期が始まった年度
+     * @type {number}
+     * @memberof RoleTerm
+     */
+    'academic_year': number;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'start_date': string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RoleTerm
+     */
+    'end_date'?: string;
+    /**
+     * 現在任期中か
+     * @type {boolean}
+     * @memberof RoleTerm
+     */
+    'current': boolean;
+}
+
@@ -0,0 +1,45 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { MemberSummary } from './member-summary';
+
+/**
+ * 
+ * @export
+ * @interface SimilarMember
+ */
+export interface SimilarMember {
+    /**
+     * 
+     * @type {MemberSummary}
+     * @memberof SimilarMember
+     */
+    'member': MemberSummary;
+    /**
+     * 興味の近さ（0〜1、大きいほど近い）
+     * @type {number}
+     * @memberof SimilarMember
+     */
+    'score': number;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SimilarMember
+     */
+    'shared_tags': Array<string>;
+}
+
@@ -15,34 +15,34 @@
 
 
 /**
- * 
+ * SNSのユーザー名と個人サイト。保存時に形式を確認し、ユーザー名・URLにそろえます
  * @export
- * @interface LineUser
+ * @interface SocialHandles
  */
-export interface LineUser {
+export interface SocialHandles {
     /**
-     * LINEユーザーID
+     * 
      * @type {string}
-     * @memberof LineUser
+     * @memberof SocialHandles
      */
-    'user_id': string;
+    'x'?: string;
     /**
-     * 表示名
+     * 
      * @type {string}
-     * @memberof LineUser
+     * @memberof SocialHandles
      */
-    'display_name': string;
+    'instagram'?: string;
     /**
-     * プロフィール画像URL
+     * 
      * @type {string}
-     * @memberof LineUser
+     * @memberof SocialHandles
      */
-    'picture_url'?: string;
+    'github'?: string;
     /**
-     * ステータスメッセージ
+     * 
      * @type {string}
-     * @memberof LineUser
+     * @memberof SocialHandles
      */
-    'status_message'?: string;
+    'website'?: string;
 }
 
@@ -0,0 +1,42 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { SocialHandles } from './social-handles';
+// May contain unused imports in some cases
+// @ts-ignore
+import type { Visibility } from './visibility';
+
+/**
+ * 
+ * @export
+ * @interface SocialProfile
+ */
+export interface SocialProfile {
+    /**
+     * 
+     * @type {SocialHandles}
+     * @memberof SocialProfile
+     */
+    'handles': SocialHandles;
+    /**
+     * 
+     * @type {Visibility}
+     * @memberof SocialProfile
+     */
+    'visibility': Visibility;
+}
+
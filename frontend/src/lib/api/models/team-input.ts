@@ -0,0 +1,45 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+// May contain unused imports in some cases
+// @ts-ignore
+import type { TeamKind } from './team-kind';
+
+/**
+ * 
+ * @export
+ * @interface TeamInput
+ */
+export interface TeamInput {
+    /**
+     * 
+     * @type {string}
+     * @memberof TeamInput
+     */
+    'name': string;
+    /**
+     * 
+     * @type {TeamKind}
+     * @memberof TeamInput
+     */
+    'kind': TeamKind;
+    /**
+     * 表示順（小さいほど先）
+     * @type {number}
+     * @memberof TeamInput
+     */
+    'order'?: number;
+}
+
@@ -0,0 +1,30 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * 基本情報編集API
+ * ユーザーの基本情報を編集するためのAPI
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * team=班、officer=役職（代表・会計など）
+ * @export
+ * @enum {string}
+ */
+
+export const TeamKind = {
+    Team: 'team',
+    Officer: 'officer'
+} as const;
+
+export type TeamKind = typeof TeamKind[keyof typeof TeamKind];
+
+
@@ -15,37 +15,37 @@
 
 // May contain unused imports in some cases
 // @ts-ignore
-import type { LineUser } from './line-user';
+import type { TeamKind } from './team-kind';
 
 /**
  * 
  * @export
- * @interface LineOAuthResponse
+ * @interface Team
  */
-export interface LineOAuthResponse {
+export interface Team {
     /**
-     * LINEから発行されたアクセストークン
+     * 
      * @type {string}
-     * @memberof LineOAuthResponse
+     * @memberof Team
      */
-    'access_token': string;
+    'id': string;
     /**
-     * 必要に応じて返されるリフレッシュトークン
+     * 
      * @type {string}
-     * @memberof LineOAuthResponse
+     * @memberof Team
      */
-    'refresh_token'?: string;
+    'name': string;
     /**
-     * アクセストークンの有効期限(秒)
-     * @type {number}
-     * @memberof LineOAuthResponse
+     * 
+     * @type {TeamKind}
+     * @memberof Team
      */
-    'expires_in': number;
+    'kind': TeamKind;
     /**
      * 
-     * @type {LineUser}
-     * @memberof LineOAuthResponse
+     * @type {number}
+     * @memberof Team
      */
-    'user': LineUser;
+    'order': number;
 }
 
@@ -44,5 +44,17 @@ export interface Visibility {
      * @memberof Visibility
      */
     'instagram': boolean;
+    /**
+     * 省略時は非公開
+     * @type {boolean}
+     * @memberof Visibility
+     */
+    'github'?: boolean;
+    /**
+     * 省略時は非公開
+     * @type {boolean}
+     * @memberof Visibility
+     */
+    'website'?: boolean;
 }
 